@@ -0,0 +1,56 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const AdminApiObjectIdPlaceholder = "{id}"
+
+// AdminApiObject is an arbitrary JSON document managed through the Keycloak admin REST API.
+// Paths are relative to the admin API root, for example /realms/my-realm/client-scopes
+type AdminApiObject struct {
+	Id                 string
+	CreatePath         string
+	ObjectPathTemplate string
+	Body               map[string]interface{}
+}
+
+func (object *AdminApiObject) ObjectPath() string {
+	return strings.ReplaceAll(object.ObjectPathTemplate, AdminApiObjectIdPlaceholder, object.Id)
+}
+
+func (keycloakClient *KeycloakClient) NewAdminApiObject(ctx context.Context, object *AdminApiObject) error {
+	_, location, err := keycloakClient.post(ctx, object.CreatePath, object.Body)
+	if err != nil {
+		return err
+	}
+
+	if location == "" {
+		return fmt.Errorf("POST request to %s did not return a Location header, unable to determine the id of the created object", object.CreatePath)
+	}
+
+	object.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetAdminApiObject(ctx context.Context, object *AdminApiObject) (map[string]interface{}, error) {
+	var body map[string]interface{}
+
+	err := keycloakClient.get(ctx, object.ObjectPath(), &body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAdminApiObject(ctx context.Context, object *AdminApiObject) error {
+	return keycloakClient.put(ctx, object.ObjectPath(), object.Body)
+}
+
+func (keycloakClient *KeycloakClient) DeleteAdminApiObject(ctx context.Context, object *AdminApiObject) error {
+	return keycloakClient.delete(ctx, object.ObjectPath(), nil)
+}
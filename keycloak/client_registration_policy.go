@@ -0,0 +1,89 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const clientRegistrationPolicyProviderType = "org.keycloak.services.clientregistration.policy.ClientRegistrationPolicy"
+
+// ClientRegistrationPolicy is a policy of any of the client registration policy providers, such as trusted-hosts or
+// max-clients. SubType is either "anonymous" or "authenticated", depending on the kind of registration requests the
+// policy applies to.
+type ClientRegistrationPolicy struct {
+	Id         string
+	Name       string
+	RealmId    string
+	ProviderId string
+	SubType    string
+
+	Config map[string][]string
+}
+
+func convertFromClientRegistrationPolicyToComponent(policy *ClientRegistrationPolicy) *component {
+	config := policy.Config
+	if config == nil {
+		config = make(map[string][]string)
+	}
+
+	return &component{
+		Id:           policy.Id,
+		Name:         policy.Name,
+		ParentId:     policy.RealmId,
+		ProviderId:   policy.ProviderId,
+		ProviderType: clientRegistrationPolicyProviderType,
+		SubType:      policy.SubType,
+		Config:       config,
+	}
+}
+
+func convertFromComponentToClientRegistrationPolicy(component *component, realmId string) *ClientRegistrationPolicy {
+	return &ClientRegistrationPolicy{
+		Id:         component.Id,
+		Name:       component.Name,
+		RealmId:    realmId,
+		ProviderId: component.ProviderId,
+		SubType:    component.SubType,
+		Config:     component.Config,
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewClientRegistrationPolicy(ctx context.Context, policy *ClientRegistrationPolicy) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", policy.RealmId), convertFromClientRegistrationPolicyToComponent(policy))
+	if err != nil {
+		return err
+	}
+
+	policy.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+// GetClientRegistrationPolicy returns the policy with the given id. A component of another provider is treated as not
+// found, so that a policy that was replaced by a different component is recreated.
+func (keycloakClient *KeycloakClient) GetClientRegistrationPolicy(ctx context.Context, realmId, id, providerId string) (*ClientRegistrationPolicy, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if component.ProviderType != clientRegistrationPolicyProviderType || component.ProviderId != providerId {
+		return nil, &ApiError{
+			Code:    http.StatusNotFound,
+			Message: fmt.Sprintf("component %s is not a %s client registration policy", id, providerId),
+		}
+	}
+
+	return convertFromComponentToClientRegistrationPolicy(component, realmId), nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateClientRegistrationPolicy(ctx context.Context, policy *ClientRegistrationPolicy) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", policy.RealmId, policy.Id), convertFromClientRegistrationPolicyToComponent(policy))
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientRegistrationPolicy(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
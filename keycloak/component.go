@@ -13,6 +13,7 @@ type component struct {
 	ProviderId   string              `json:"providerId"`
 	ProviderType string              `json:"providerType"`
 	ParentId     string              `json:"parentId"`
+	SubType      string              `json:"subType,omitempty"`
 	Config       map[string][]string `json:"config"`
 }
 
@@ -35,3 +36,28 @@ func (component *component) getConfigOk(val string) (string, bool) {
 func (keycloakClient *KeycloakClient) DeleteComponent(ctx context.Context, realmId, id string) error {
 	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
 }
+
+// ComponentInfo is a minimal view of a component, used when components of different providers are listed together
+type ComponentInfo struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	ProviderId   string `json:"providerId"`
+	ProviderType string `json:"providerType"`
+	ParentId     string `json:"parentId"`
+}
+
+func (keycloakClient *KeycloakClient) ListComponents(ctx context.Context, realmId, parentId, providerType string) ([]*ComponentInfo, error) {
+	var components []*ComponentInfo
+
+	params := map[string]string{
+		"parent": parentId,
+		"type":   providerType,
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components", realmId), &components, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return components, nil
+}
@@ -16,7 +16,7 @@ type GenericClient struct {
 	Description string `json:"description"`
 }
 
-func (keycloakClient *KeycloakClient) listGenericClients(ctx context.Context, realmId string) ([]*GenericClient, error) {
+func (keycloakClient *KeycloakClient) GetGenericClients(ctx context.Context, realmId string) ([]*GenericClient, error) {
 	var clients []*GenericClient
 
 	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients", realmId), &clients, nil)
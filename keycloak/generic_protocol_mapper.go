@@ -54,6 +54,22 @@ func (keycloakClient *KeycloakClient) GetGenericProtocolMappers(ctx context.Cont
 
 }
 
+func (keycloakClient *KeycloakClient) GetClientScopeGenericProtocolMappers(ctx context.Context, realmId string, clientScopeId string) ([]*GenericProtocolMapper, error) {
+	var protocolMappers []*GenericProtocolMapper
+
+	err := keycloakClient.get(ctx, protocolMapperPath(realmId, "", clientScopeId), &protocolMappers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		protocolMapper.RealmId = realmId
+		protocolMapper.ClientScopeId = clientScopeId
+	}
+
+	return protocolMappers, nil
+}
+
 func (keycloakClient *KeycloakClient) GetGenericProtocolMapper(ctx context.Context, realmId string, clientId string, clientScopeId string, mapperId string) (*GenericProtocolMapper, error) {
 	var genericProtocolMapper GenericProtocolMapper
 
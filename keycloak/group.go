@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -183,6 +184,31 @@ func getGroupByDFS(groupName string, groups []*Group) *Group {
 	return nil
 }
 
+func (keycloakClient *KeycloakClient) GetGroupByPath(ctx context.Context, realmId, path string) (*Group, error) {
+	var group Group
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/group-by-path/%s", realmId, strings.Join(segments, "/")), &group, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	group.RealmId = realmId
+
+	parentId, err := keycloakClient.groupParentId(ctx, &group)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ParentId = parentId
+
+	return &group, nil
+}
+
 func (keycloakClient *KeycloakClient) UpdateGroup(ctx context.Context, group *Group) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/groups/%s", group.RealmId, group.Id), group)
 }
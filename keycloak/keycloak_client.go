@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,6 +16,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-version"
@@ -35,6 +37,13 @@ type KeycloakClient struct {
 	additionalHeaders map[string]string
 	debug             bool
 	redHatSSO         bool
+	serverInfo        *ServerInfo
+	serverInfoMutex   sync.Mutex
+	smtpTestMutex     sync.Mutex
+
+	deletionProtection bool
+	allowedRealms      []string
+	deniedRealms       []string
 }
 
 type ClientCredentials struct {
@@ -111,6 +120,70 @@ func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecre
 	return &keycloakClient, nil
 }
 
+// SetDeletionProtection sets the default for the deletion_protection attribute of resources that support it. It is
+// configured at the provider level, and stored on the client because the client is what resources receive as meta.
+func (keycloakClient *KeycloakClient) SetDeletionProtection(deletionProtection bool) {
+	keycloakClient.deletionProtection = deletionProtection
+}
+
+func (keycloakClient *KeycloakClient) DeletionProtection() bool {
+	return keycloakClient.deletionProtection
+}
+
+// getAuthenticatedUserClaims returns the subject and the email claim of the access token of the provider.
+func (keycloakClient *KeycloakClient) getAuthenticatedUserClaims(ctx context.Context) (string, string, error) {
+	if !keycloakClient.initialLogin {
+		keycloakClient.initialLogin = true
+		err := keycloakClient.login(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("error logging in: %s", err)
+		}
+	}
+
+	tokenParts := strings.Split(keycloakClient.clientCredentials.AccessToken, ".")
+	if len(tokenParts) != 3 {
+		return "", "", fmt.Errorf("the access token of the provider is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(tokenParts[1])
+	if err != nil {
+		return "", "", fmt.Errorf("error decoding the access token of the provider: %s", err)
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+		Email   string `json:"email"`
+	}
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return "", "", fmt.Errorf("error decoding the access token of the provider: %s", err)
+	}
+
+	return claims.Subject, claims.Email, nil
+}
+
+// GetAuthenticatedUserEmail returns the email address of the user the provider is authenticated as, which is where
+// Keycloak sends SMTP test emails. The email claim of the access token is used when it is present, and the user is
+// looked up by the subject of the token otherwise.
+func (keycloakClient *KeycloakClient) GetAuthenticatedUserEmail(ctx context.Context) (string, error) {
+	subject, email, err := keycloakClient.getAuthenticatedUserClaims(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if email != "" || subject == "" {
+		return email, nil
+	}
+
+	user, err := keycloakClient.GetUser(ctx, keycloakClient.realm, subject)
+	if err != nil {
+		return "", err
+	}
+
+	return user.Email, nil
+}
+
 func (keycloakClient *KeycloakClient) login(ctx context.Context) error {
 	accessTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.realm)
 	accessTokenData := keycloakClient.getAuthenticationFormData()
@@ -398,6 +471,10 @@ func (keycloakClient *KeycloakClient) get(ctx context.Context, path string, reso
 }
 
 func (keycloakClient *KeycloakClient) getRaw(ctx context.Context, path string, params map[string]string) ([]byte, error) {
+	if err := keycloakClient.checkRealmRestrictions(path); err != nil {
+		return nil, err
+	}
+
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceUrl, nil)
@@ -418,6 +495,10 @@ func (keycloakClient *KeycloakClient) getRaw(ctx context.Context, path string, p
 }
 
 func (keycloakClient *KeycloakClient) sendRaw(ctx context.Context, path string, requestBody []byte) ([]byte, error) {
+	if err := keycloakClient.checkRealmRestrictions(path); err != nil {
+		return nil, err
+	}
+
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
 	request, err := http.NewRequestWithContext(ctx, http.MethodPost, resourceUrl, nil)
@@ -431,6 +512,10 @@ func (keycloakClient *KeycloakClient) sendRaw(ctx context.Context, path string,
 }
 
 func (keycloakClient *KeycloakClient) post(ctx context.Context, path string, requestBody interface{}) ([]byte, string, error) {
+	if err := keycloakClient.checkRealmRestrictions(path); err != nil {
+		return nil, "", err
+	}
+
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
 	payload, err := keycloakClient.marshal(requestBody)
@@ -449,6 +534,10 @@ func (keycloakClient *KeycloakClient) post(ctx context.Context, path string, req
 }
 
 func (keycloakClient *KeycloakClient) put(ctx context.Context, path string, requestBody interface{}) error {
+	if err := keycloakClient.checkRealmRestrictions(path); err != nil {
+		return err
+	}
+
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
 	payload, err := keycloakClient.marshal(requestBody)
@@ -467,6 +556,10 @@ func (keycloakClient *KeycloakClient) put(ctx context.Context, path string, requ
 }
 
 func (keycloakClient *KeycloakClient) delete(ctx context.Context, path string, requestBody interface{}) error {
+	if err := keycloakClient.checkRealmRestrictions(path); err != nil {
+		return err
+	}
+
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
 	var (
@@ -124,7 +124,7 @@ func (keycloakClient *KeycloakClient) ValidateOpenIdAudienceProtocolMapper(ctx c
 	}
 
 	if mapper.IncludedClientAudience != "" {
-		clients, err := keycloakClient.listGenericClients(ctx, mapper.RealmId)
+		clients, err := keycloakClient.GetGenericClients(ctx, mapper.RealmId)
 		if err != nil {
 			return err
 		}
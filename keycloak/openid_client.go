@@ -58,6 +58,8 @@ type OpenidClient struct {
 	AuthenticationFlowBindingOverrides OpenidAuthenticationFlowBindingOverrides `json:"authenticationFlowBindingOverrides,omitempty"`
 }
 
+const DpopFeature = "DPOP"
+
 type OpenidClientAttributes struct {
 	PkceCodeChallengeMethod               string                           `json:"pkce.code.challenge.method"`
 	ExcludeSessionStateFromAuthResponse   types.KeycloakBoolQuoted         `json:"exclude.session.state.from.auth.response"`
@@ -80,6 +82,10 @@ type OpenidClientAttributes struct {
 	Oauth2DeviceCodeLifespan              string                           `json:"oauth2.device.code.lifespan,omitempty"`
 	Oauth2DevicePollingInterval           string                           `json:"oauth2.device.polling.interval,omitempty"`
 	PostLogoutRedirectUris                types.KeycloakSliceHashDelimited `json:"post.logout.redirect.uris,omitempty"`
+	RequirePushedAuthorizationRequests    types.KeycloakBoolQuoted         `json:"require.pushed.authorization.requests"`
+	DpopBoundAccessTokens                 types.KeycloakBoolQuoted         `json:"dpop.bound.access.tokens"`
+	CibaGrantEnabled                      types.KeycloakBoolQuoted         `json:"oidc.ciba.grant.enabled"`
+	CibaBackchannelAuthRequestSigningAlg  string                           `json:"ciba.backchannel.auth.request.signing.alg,omitempty"`
 }
 
 type OpenidAuthenticationFlowBindingOverrides struct {
@@ -122,6 +128,32 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context,
 		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server", client.Attributes.LoginTheme)
 	}
 
+	if client.Attributes.CibaGrantEnabled || client.Attributes.RequirePushedAuthorizationRequests {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_13)
+		if err != nil {
+			return err
+		}
+
+		if !versionOk {
+			return fmt.Errorf("validation error: the CIBA grant and pushed authorization requests require Keycloak 13 or later")
+		}
+	}
+
+	if bool(client.Attributes.CibaGrantEnabled) && client.PublicClient {
+		return fmt.Errorf("validation error: the CIBA grant cannot be enabled on public clients")
+	}
+
+	if client.Attributes.DpopBoundAccessTokens {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_23)
+		if err != nil {
+			return err
+		}
+
+		if !versionOk || !serverInfo.FeatureIsEnabled(DpopFeature) {
+			return fmt.Errorf("validation error: DPoP bound access tokens require Keycloak 23 or later with the dpop feature enabled")
+		}
+	}
+
 	return nil
 }
 
@@ -227,10 +259,19 @@ func (keycloakClient *KeycloakClient) GetOpenidClientByClientId(ctx context.Cont
 	return &client, nil
 }
 
+// UpdateOpenidClient merges the given client into the live client representation, so attributes that are not modeled by
+// OpenidClientAttributes are kept.
 func (keycloakClient *KeycloakClient) UpdateOpenidClient(ctx context.Context, client *OpenidClient) error {
 	client.Protocol = "openid-connect"
 
-	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s", client.RealmId, client.Id), client)
+	path := fmt.Sprintf("/realms/%s/clients/%s", client.RealmId, client.Id)
+
+	mergedClient, err := keycloakClient.mergeWithLiveRepresentation(ctx, path, client, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, path, mergedClient)
 }
 
 func (keycloakClient *KeycloakClient) DeleteOpenidClient(ctx context.Context, realmId, id string) error {
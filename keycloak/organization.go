@@ -0,0 +1,176 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const OrganizationFeature = "ORGANIZATION"
+
+// The configuration of an identity provider that is linked to an organization is stored with the following keys.
+const (
+	OrganizationIdentityProviderConfigKeyPrefix   = "kc.org"
+	OrganizationIdentityProviderDomainConfigKey   = "kc.org.domain"
+	OrganizationIdentityProviderRedirectConfigKey = "kc.org.broker.redirect.mode.email-matches"
+)
+
+type OrganizationDomain struct {
+	Name     string `json:"name"`
+	Verified bool   `json:"verified"`
+}
+
+type Organization struct {
+	Id          string               `json:"id,omitempty"`
+	RealmId     string               `json:"-"`
+	Name        string               `json:"name"`
+	Alias       string               `json:"alias,omitempty"`
+	Enabled     bool                 `json:"enabled"`
+	Description string               `json:"description"`
+	RedirectUrl string               `json:"redirectUrl"`
+	Attributes  map[string][]string  `json:"attributes"`
+	Domains     []OrganizationDomain `json:"domains"`
+}
+
+type OrganizationMember struct {
+	Id             string `json:"id"`
+	Username       string `json:"username"`
+	MembershipType string `json:"membershipType,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) NewOrganization(ctx context.Context, organization *Organization) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/organizations", organization.RealmId), organization)
+	if err != nil {
+		return err
+	}
+
+	organization.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetOrganization(ctx context.Context, realmId, id string) (*Organization, error) {
+	var organization Organization
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations/%s", realmId, id), &organization, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	organization.RealmId = realmId
+
+	return &organization, nil
+}
+
+// GetOrganizationByName returns the organization with the given name. The search endpoint also matches organizations
+// by their domains, so the results are filtered by name.
+func (keycloakClient *KeycloakClient) GetOrganizationByName(ctx context.Context, realmId, name string) (*Organization, error) {
+	var organizations []*Organization
+
+	params := map[string]string{
+		"search": name,
+		"exact":  "true",
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations", realmId), &organizations, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, organization := range organizations {
+		if organization.Name == name {
+			organization.RealmId = realmId
+
+			return organization, nil
+		}
+	}
+
+	return nil, fmt.Errorf("organization with name %s does not exist in realm %s", name, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateOrganization(ctx context.Context, organization *Organization) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/organizations/%s", organization.RealmId, organization.Id), organization)
+}
+
+func (keycloakClient *KeycloakClient) DeleteOrganization(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/organizations/%s", realmId, id), nil)
+}
+
+// AddOrganizationMember adds an existing user to the organization as an unmanaged member. The endpoint expects the
+// plain user ID as the request body.
+func (keycloakClient *KeycloakClient) AddOrganizationMember(ctx context.Context, realmId, organizationId, userId string) error {
+	_, err := keycloakClient.sendRaw(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members", realmId, organizationId), []byte(userId))
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) GetOrganizationMember(ctx context.Context, realmId, organizationId, userId string) (*OrganizationMember, error) {
+	var member OrganizationMember
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members/%s", realmId, organizationId, userId), &member, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+func (keycloakClient *KeycloakClient) RemoveOrganizationMember(ctx context.Context, realmId, organizationId, userId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members/%s", realmId, organizationId, userId), nil)
+}
+
+// LinkOrganizationIdentityProvider links an existing identity provider to the organization. The endpoint expects the
+// plain alias as the request body.
+func (keycloakClient *KeycloakClient) LinkOrganizationIdentityProvider(ctx context.Context, realmId, organizationId, alias string) error {
+	_, err := keycloakClient.sendRaw(ctx, fmt.Sprintf("/realms/%s/organizations/%s/identity-providers", realmId, organizationId), []byte(alias))
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) GetOrganizationIdentityProvider(ctx context.Context, realmId, organizationId, alias string) (*IdentityProvider, error) {
+	var identityProvider IdentityProvider
+	identityProvider.Realm = realmId
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations/%s/identity-providers/%s", realmId, organizationId, url.PathEscape(alias)), &identityProvider, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identityProvider, nil
+}
+
+func (keycloakClient *KeycloakClient) UnlinkOrganizationIdentityProvider(ctx context.Context, realmId, organizationId, alias string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/organizations/%s/identity-providers/%s", realmId, organizationId, url.PathEscape(alias)), nil)
+}
+
+// KeepOrganizationLink copies the organization that the live identity provider is linked to, along with the
+// organization specific configuration, so that updating the identity provider does not unlink it. Older versions of
+// Keycloak store the ID of the organization in the configuration instead of the organizationId field.
+func (identityProvider *IdentityProvider) KeepOrganizationLink(liveIdentityProvider *IdentityProvider) {
+	if liveIdentityProvider.OrganizationId != "" {
+		identityProvider.OrganizationId = liveIdentityProvider.OrganizationId
+	}
+
+	if liveIdentityProvider.Config == nil {
+		return
+	}
+
+	for key, value := range liveIdentityProvider.Config.ExtraConfig {
+		if key != OrganizationIdentityProviderConfigKeyPrefix && !strings.HasPrefix(key, OrganizationIdentityProviderConfigKeyPrefix+".") {
+			continue
+		}
+
+		if identityProvider.Config == nil {
+			identityProvider.Config = &IdentityProviderConfig{}
+		}
+
+		if identityProvider.Config.ExtraConfig == nil {
+			identityProvider.Config.ExtraConfig = map[string]interface{}{}
+		}
+
+		if _, ok := identityProvider.Config.ExtraConfig[key]; !ok {
+			identityProvider.Config.ExtraConfig[key] = value
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package keycloak
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeepOrganizationLink(t *testing.T) {
+	live := &IdentityProvider{
+		Alias:          "corporate",
+		OrganizationId: "b2a5c1d4",
+		Config: &IdentityProviderConfig{
+			ExtraConfig: map[string]interface{}{
+				"kc.org.domain": "example.com",
+				"kc.org.broker.redirect.mode.email-matches": "true",
+				"unmanaged": "value",
+			},
+		},
+	}
+
+	update := &IdentityProvider{
+		Alias: "corporate",
+		Config: &IdentityProviderConfig{
+			ExtraConfig: map[string]interface{}{
+				"kc.org.domain": "example.org",
+			},
+		},
+	}
+
+	update.KeepOrganizationLink(live)
+
+	if update.OrganizationId != "b2a5c1d4" {
+		t.Errorf("expected organization ID to be kept, got %q", update.OrganizationId)
+	}
+
+	expected := map[string]interface{}{
+		"kc.org.domain": "example.org",
+		"kc.org.broker.redirect.mode.email-matches": "true",
+	}
+
+	if !reflect.DeepEqual(update.Config.ExtraConfig, expected) {
+		t.Errorf("expected extra config %v, got %v", expected, update.Config.ExtraConfig)
+	}
+}
+
+func TestKeepOrganizationLinkWithoutOrganization(t *testing.T) {
+	live := &IdentityProvider{
+		Alias:  "corporate",
+		Config: &IdentityProviderConfig{},
+	}
+
+	update := &IdentityProvider{
+		Alias: "corporate",
+	}
+
+	update.KeepOrganizationLink(live)
+
+	if update.OrganizationId != "" || update.Config != nil {
+		t.Errorf("expected identity provider to be unchanged, got %+v", update)
+	}
+}
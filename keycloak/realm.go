@@ -30,6 +30,8 @@ type Realm struct {
 	DisplayNameHtml   string `json:"displayNameHtml"`
 	UserManagedAccess bool   `json:"userManagedAccessAllowed"`
 
+	OrganizationsEnabled *bool `json:"organizationsEnabled,omitempty"`
+
 	// Login Config
 	RegistrationAllowed         bool   `json:"registrationAllowed"`
 	RegistrationEmailAsUsername bool   `json:"registrationEmailAsUsername"`
@@ -169,6 +171,10 @@ type SmtpServer struct {
 }
 
 func (keycloakClient *KeycloakClient) NewRealm(ctx context.Context, realm *Realm) error {
+	if err := keycloakClient.RealmIsManageable(realm.Realm); err != nil {
+		return err
+	}
+
 	_, _, err := keycloakClient.post(ctx, "/realms", realm)
 
 	return err
@@ -206,8 +212,138 @@ func (keycloakClient *KeycloakClient) GetRealmKeys(ctx context.Context, name str
 	return &keys, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateRealm(ctx context.Context, realm *Realm) error {
-	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s", realm.Realm), realm)
+// The top level fields of the realm representation that are managed by the realm settings resources, such as
+// keycloak_realm_smtp_server. These resources only update their own fields, and keycloak_realm leaves them untouched
+// unless they are configured on the realm.
+var (
+	RealmSmtpServerFields = []string{"smtpServer"}
+
+	RealmSecurityDefensesFields = []string{
+		"browserSecurityHeaders",
+		"bruteForceProtected",
+		"permanentLockout",
+		"failureFactor",
+		"waitIncrementSeconds",
+		"quickLoginCheckMilliSeconds",
+		"minimumQuickLoginWaitSeconds",
+		"maxFailureWaitSeconds",
+		"maxDeltaTimeSeconds",
+	}
+
+	RealmOtpPolicyFields = []string{
+		"otpPolicyAlgorithm",
+		"otpPolicyDigits",
+		"otpPolicyInitialCounter",
+		"otpPolicyLookAheadWindow",
+		"otpPolicyPeriod",
+		"otpPolicyType",
+	}
+
+	RealmWebAuthnPolicyFields = []string{
+		"webAuthnPolicyAcceptableAaguids",
+		"webAuthnPolicyAttestationConveyancePreference",
+		"webAuthnPolicyAuthenticatorAttachment",
+		"webAuthnPolicyAvoidSameAuthenticatorRegister",
+		"webAuthnPolicyCreateTimeout",
+		"webAuthnPolicyRequireResidentKey",
+		"webAuthnPolicyRpEntityName",
+		"webAuthnPolicyRpId",
+		"webAuthnPolicySignatureAlgorithms",
+		"webAuthnPolicyUserVerificationRequirement",
+	}
+
+	RealmWebAuthnPasswordlessPolicyFields = []string{
+		"webAuthnPolicyPasswordlessAcceptableAaguids",
+		"webAuthnPolicyPasswordlessAttestationConveyancePreference",
+		"webAuthnPolicyPasswordlessAuthenticatorAttachment",
+		"webAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister",
+		"webAuthnPolicyPasswordlessCreateTimeout",
+		"webAuthnPolicyPasswordlessRequireResidentKey",
+		"webAuthnPolicyPasswordlessRpEntityName",
+		"webAuthnPolicyPasswordlessRpId",
+		"webAuthnPolicyPasswordlessSignatureAlgorithms",
+		"webAuthnPolicyPasswordlessUserVerificationRequirement",
+	}
+)
+
+// UpdateRealm merges the given realm into the live realm representation, so fields that are not modeled by Realm are kept.
+// Attributes that are no longer managed can be passed as removedAttributes.
+func (keycloakClient *KeycloakClient) UpdateRealm(ctx context.Context, realm *Realm, removedAttributes ...string) error {
+	return keycloakClient.updateRealm(ctx, realm, nil, removedAttributes)
+}
+
+// UpdateRealmExcludingFields works like UpdateRealm, but keeps the live value of the given top level fields.
+func (keycloakClient *KeycloakClient) UpdateRealmExcludingFields(ctx context.Context, realm *Realm, excludedFields []string, removedAttributes ...string) error {
+	return keycloakClient.updateRealm(ctx, realm, func(field string) bool {
+		return !contains(excludedFields, field)
+	}, removedAttributes)
+}
+
+// UpdateRealmFields only updates the given top level fields of the realm, and keeps the live value of all other fields.
+func (keycloakClient *KeycloakClient) UpdateRealmFields(ctx context.Context, realm *Realm, fields []string) error {
+	return keycloakClient.updateRealm(ctx, realm, func(field string) bool {
+		return contains(fields, field)
+	}, nil)
+}
+
+// TestRealmSmtpConnection sends a test email with the given SMTP settings, and Keycloak responds with an error when the
+// email cannot be sent. Keycloak always sends it to the email address of the user the provider is authenticated as, so
+// when a recipient is given, that address is set on the user while the email is sent, and restored afterwards.
+func (keycloakClient *KeycloakClient) TestRealmSmtpConnection(ctx context.Context, realmId string, smtpServer SmtpServer, recipient string) error {
+	if recipient == "" {
+		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/testSMTPConnection", realmId), smtpServer)
+
+		return err
+	}
+
+	keycloakClient.smtpTestMutex.Lock()
+	defer keycloakClient.smtpTestMutex.Unlock()
+
+	subject, _, err := keycloakClient.getAuthenticatedUserClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := keycloakClient.GetUser(ctx, keycloakClient.realm, subject)
+	if err != nil {
+		return fmt.Errorf("error getting the user the provider is authenticated as: %s", err)
+	}
+
+	email := user.Email
+	user.Email = recipient
+
+	err = keycloakClient.UpdateUser(ctx, user)
+	if err != nil {
+		return fmt.Errorf("error setting the email address of the user the provider is authenticated as to %s: %s", recipient, err)
+	}
+
+	_, _, testErr := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/testSMTPConnection", realmId), smtpServer)
+
+	user.Email = email
+
+	err = keycloakClient.UpdateUser(ctx, user)
+	if err != nil {
+		return fmt.Errorf("error restoring the email address of the user the provider is authenticated as to %s: %s", email, err)
+	}
+
+	return testErr
+}
+
+// TestSmtpConnection sends a test email with the given SMTP settings through the realm the provider is authenticated
+// against, for settings of a realm that does not exist yet.
+func (keycloakClient *KeycloakClient) TestSmtpConnection(ctx context.Context, smtpServer SmtpServer, recipient string) error {
+	return keycloakClient.TestRealmSmtpConnection(ctx, keycloakClient.realm, smtpServer, recipient)
+}
+
+func (keycloakClient *KeycloakClient) updateRealm(ctx context.Context, realm *Realm, includeField func(field string) bool, removedAttributes []string) error {
+	path := fmt.Sprintf("/realms/%s", realm.Realm)
+
+	mergedRealm, err := keycloakClient.mergeWithLiveRepresentation(ctx, path, realm, includeField, removedAttributes)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, path, mergedRealm)
 }
 
 func (keycloakClient *KeycloakClient) DeleteRealm(ctx context.Context, name string) error {
@@ -220,6 +356,14 @@ func (keycloakClient *KeycloakClient) DeleteRealm(ctx context.Context, name stri
 	return nil
 }
 
+// The CIBA policy of a realm is stored in its attributes
+const (
+	RealmAttributeCibaBackchannelTokenDeliveryMode = "cibaBackchannelTokenDeliveryMode"
+	RealmAttributeCibaExpiresIn                    = "cibaExpiresIn"
+	RealmAttributeCibaInterval                     = "cibaInterval"
+	RealmAttributeCibaAuthRequestedUserHint        = "cibaAuthRequestedUserHint"
+)
+
 func (keycloakClient *KeycloakClient) ValidateRealm(ctx context.Context, realm *Realm) error {
 	if realm.DuplicateEmailsAllowed == true && realm.RegistrationEmailAsUsername == true {
 		return fmt.Errorf("validation error: DuplicateEmailsAllowed cannot be true if RegistrationEmailAsUsername is true")
@@ -255,6 +399,17 @@ func (keycloakClient *KeycloakClient) ValidateRealm(ctx context.Context, realm *
 		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server", realm.EmailTheme)
 	}
 
+	if _, ok := realm.Attributes[RealmAttributeCibaBackchannelTokenDeliveryMode]; ok {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_13)
+		if err != nil {
+			return err
+		}
+
+		if !versionOk {
+			return fmt.Errorf("validation error: the CIBA policy requires Keycloak 13 or later")
+		}
+	}
+
 	if realm.InternationalizationEnabled == true && !contains(realm.SupportLocales, realm.DefaultLocale) {
 		return fmt.Errorf("validation error: DefaultLocale should be in the SupportLocales")
 	}
@@ -263,8 +418,8 @@ func (keycloakClient *KeycloakClient) ValidateRealm(ctx context.Context, realm *
 		policies := strings.Split(realm.PasswordPolicy, " and ")
 		for _, policyTypeRepresentation := range policies {
 			policy := strings.Split(policyTypeRepresentation, "(")
-			if !serverInfo.providerInstalled("password-policy", policy[0]) {
-				return fmt.Errorf("validation error: password-policy \"%s\" does not exist on the server, installed providers: %s", policy[0], serverInfo.getInstalledProvidersNames("password-policy"))
+			if !serverInfo.ProviderIsInstalled("password-policy", policy[0]) {
+				return fmt.Errorf("validation error: password-policy \"%s\" does not exist on the server, installed providers: %s", policy[0], serverInfo.GetInstalledProvidersNames("password-policy"))
 			}
 		}
 	}
@@ -0,0 +1,159 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type RealmClientPolicyProfileExecutor struct {
+	Executor      string                 `json:"executor"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type RealmClientPolicyProfile struct {
+	Name        string                              `json:"name"`
+	Description string                              `json:"description,omitempty"`
+	Executors   []*RealmClientPolicyProfileExecutor `json:"executors"`
+}
+
+type RealmClientPolicyProfiles struct {
+	Profiles       []*RealmClientPolicyProfile `json:"profiles"`
+	GlobalProfiles []*RealmClientPolicyProfile `json:"globalProfiles,omitempty"`
+}
+
+type RealmClientPolicyCondition struct {
+	Condition     string                 `json:"condition"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type RealmClientPolicy struct {
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Enabled     bool                          `json:"enabled"`
+	Conditions  []*RealmClientPolicyCondition `json:"conditions"`
+	Profiles    []string                      `json:"profiles"`
+}
+
+type RealmClientPolicies struct {
+	Policies       []*RealmClientPolicy `json:"policies"`
+	GlobalPolicies []*RealmClientPolicy `json:"globalPolicies,omitempty"`
+}
+
+// GetRealmClientPolicyProfiles returns the client profiles of the realm, along with the global client profiles that are
+// built into Keycloak.
+func (keycloakClient *KeycloakClient) GetRealmClientPolicyProfiles(ctx context.Context, realmId string) (*RealmClientPolicyProfiles, error) {
+	var profiles RealmClientPolicyProfiles
+
+	params := map[string]string{
+		"include-global-profiles": "true",
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-policies/profiles", realmId), &profiles, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profiles, nil
+}
+
+// UpdateRealmClientPolicyProfiles replaces all client profiles of the realm. Global client profiles cannot be updated,
+// so they are never sent.
+func (keycloakClient *KeycloakClient) UpdateRealmClientPolicyProfiles(ctx context.Context, realmId string, profiles []*RealmClientPolicyProfile) error {
+	if profiles == nil {
+		profiles = []*RealmClientPolicyProfile{}
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/client-policies/profiles", realmId), RealmClientPolicyProfiles{
+		Profiles: profiles,
+	})
+}
+
+// GetRealmClientPolicies returns the client policies of the realm, along with the global client policies that are
+// built into Keycloak.
+func (keycloakClient *KeycloakClient) GetRealmClientPolicies(ctx context.Context, realmId string) (*RealmClientPolicies, error) {
+	var policies RealmClientPolicies
+
+	params := map[string]string{
+		"include-global-policies": "true",
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-policies/policies", realmId), &policies, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policies, nil
+}
+
+// UpdateRealmClientPolicies replaces all client policies of the realm. Global client policies cannot be updated, so
+// they are never sent.
+func (keycloakClient *KeycloakClient) UpdateRealmClientPolicies(ctx context.Context, realmId string, policies []*RealmClientPolicy) error {
+	if policies == nil {
+		policies = []*RealmClientPolicy{}
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/client-policies/policies", realmId), RealmClientPolicies{
+		Policies: policies,
+	})
+}
+
+func (profiles *RealmClientPolicyProfiles) IsGlobal(name string) bool {
+	for _, profile := range profiles.GlobalProfiles {
+		if profile.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (policies *RealmClientPolicies) IsGlobal(name string) bool {
+	for _, policy := range policies.GlobalPolicies {
+		if policy.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetRealmClientPolicyProfile returns the client profile of the realm with the given name. A 404 error is returned when
+// the realm has no such profile.
+func (keycloakClient *KeycloakClient) GetRealmClientPolicyProfile(ctx context.Context, realmId, name string) (*RealmClientPolicyProfile, error) {
+	profiles, err := keycloakClient.GetRealmClientPolicyProfiles(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles.Profiles {
+		if profile.Name == name {
+			return profile, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("client profile %s does not exist in realm %s", name, realmId),
+	}
+}
+
+// GetRealmClientPolicy returns the client policy of the realm with the given name. A 404 error is returned when the
+// realm has no such policy.
+func (keycloakClient *KeycloakClient) GetRealmClientPolicy(ctx context.Context, realmId, name string) (*RealmClientPolicy, error) {
+	policies, err := keycloakClient.GetRealmClientPolicies(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies.Policies {
+		if policy.Name == name {
+			return policy, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("client policy %s does not exist in realm %s", name, realmId),
+	}
+}
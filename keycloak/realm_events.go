@@ -3,6 +3,8 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
 type RealmEventsConfig struct {
@@ -28,3 +30,120 @@ func (keycloakClient *KeycloakClient) GetRealmEventsConfig(ctx context.Context,
 func (keycloakClient *KeycloakClient) UpdateRealmEventsConfig(ctx context.Context, realmId string, realmEventsConfig *RealmEventsConfig) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/events/config", realmId), realmEventsConfig)
 }
+
+type RealmEvent struct {
+	Time      int64             `json:"time"`
+	Type      string            `json:"type"`
+	RealmId   string            `json:"realmId"`
+	ClientId  string            `json:"clientId"`
+	UserId    string            `json:"userId"`
+	SessionId string            `json:"sessionId"`
+	IpAddress string            `json:"ipAddress"`
+	Error     string            `json:"error"`
+	Details   map[string]string `json:"details"`
+}
+
+type RealmAdminEventAuthDetails struct {
+	RealmId   string `json:"realmId"`
+	ClientId  string `json:"clientId"`
+	UserId    string `json:"userId"`
+	IpAddress string `json:"ipAddress"`
+}
+
+type RealmAdminEvent struct {
+	Time           int64                       `json:"time"`
+	RealmId        string                      `json:"realmId"`
+	AuthDetails    *RealmAdminEventAuthDetails `json:"authDetails"`
+	OperationType  string                      `json:"operationType"`
+	ResourceType   string                      `json:"resourceType"`
+	ResourcePath   string                      `json:"resourcePath"`
+	Representation string                      `json:"representation"`
+	Error          string                      `json:"error"`
+}
+
+// RealmEventQuery holds the filters of a query for login events. Empty filters are not sent. Dates use the yyyy-MM-dd
+// format.
+type RealmEventQuery struct {
+	Types     []string
+	ClientId  string
+	UserId    string
+	IpAddress string
+	DateFrom  string
+	DateTo    string
+	First     int
+	Max       int
+}
+
+// RealmAdminEventQuery holds the filters of a query for admin events. Empty filters are not sent. Dates use the
+// yyyy-MM-dd format.
+type RealmAdminEventQuery struct {
+	OperationTypes []string
+	ResourceTypes  []string
+	ResourcePath   string
+	AuthRealmId    string
+	AuthClientId   string
+	AuthUserId     string
+	AuthIpAddress  string
+	DateFrom       string
+	DateTo         string
+	First          int
+	Max            int
+}
+
+func addQueryValue(query url.Values, key, value string) {
+	if value != "" {
+		query.Add(key, value)
+	}
+}
+
+// The type filters can be repeated, which the params of get do not support, so the query is sent as part of the path.
+func (keycloakClient *KeycloakClient) GetRealmEvents(ctx context.Context, realmId string, eventQuery *RealmEventQuery) ([]*RealmEvent, error) {
+	var events []*RealmEvent
+
+	query := url.Values{}
+	for _, eventType := range eventQuery.Types {
+		query.Add("type", eventType)
+	}
+	addQueryValue(query, "client", eventQuery.ClientId)
+	addQueryValue(query, "user", eventQuery.UserId)
+	addQueryValue(query, "ipAddress", eventQuery.IpAddress)
+	addQueryValue(query, "dateFrom", eventQuery.DateFrom)
+	addQueryValue(query, "dateTo", eventQuery.DateTo)
+	query.Add("first", strconv.Itoa(eventQuery.First))
+	query.Add("max", strconv.Itoa(eventQuery.Max))
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/events?%s", realmId, query.Encode()), &events, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmAdminEvents(ctx context.Context, realmId string, eventQuery *RealmAdminEventQuery) ([]*RealmAdminEvent, error) {
+	var events []*RealmAdminEvent
+
+	query := url.Values{}
+	for _, operationType := range eventQuery.OperationTypes {
+		query.Add("operationTypes", operationType)
+	}
+	for _, resourceType := range eventQuery.ResourceTypes {
+		query.Add("resourceTypes", resourceType)
+	}
+	addQueryValue(query, "resourcePath", eventQuery.ResourcePath)
+	addQueryValue(query, "authRealm", eventQuery.AuthRealmId)
+	addQueryValue(query, "authClient", eventQuery.AuthClientId)
+	addQueryValue(query, "authUser", eventQuery.AuthUserId)
+	addQueryValue(query, "authIpAddress", eventQuery.AuthIpAddress)
+	addQueryValue(query, "dateFrom", eventQuery.DateFrom)
+	addQueryValue(query, "dateTo", eventQuery.DateTo)
+	query.Add("first", strconv.Itoa(eventQuery.First))
+	query.Add("max", strconv.Itoa(eventQuery.Max))
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/admin-events?%s", realmId, query.Encode()), &events, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,139 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// RealmGeneratedKey is a key of any of the generated key providers, such as rsa-generated or ecdsa-generated. The
+// provider specific configuration, such as the key size, is kept in Config.
+type RealmGeneratedKey struct {
+	Id         string
+	Name       string
+	RealmId    string
+	ProviderId string
+
+	Active   bool
+	Enabled  bool
+	Priority int
+
+	Config map[string]string
+}
+
+func convertFromRealmGeneratedKeyToComponent(realmKey *RealmGeneratedKey) *component {
+	componentConfig := map[string][]string{
+		"active": {
+			strconv.FormatBool(realmKey.Active),
+		},
+		"enabled": {
+			strconv.FormatBool(realmKey.Enabled),
+		},
+		"priority": {
+			strconv.Itoa(realmKey.Priority),
+		},
+	}
+
+	for key, value := range realmKey.Config {
+		componentConfig[key] = []string{value}
+	}
+
+	return &component{
+		Id:           realmKey.Id,
+		Name:         realmKey.Name,
+		ParentId:     realmKey.RealmId,
+		ProviderId:   realmKey.ProviderId,
+		ProviderType: "org.keycloak.keys.KeyProvider",
+		Config:       componentConfig,
+	}
+}
+
+func convertFromComponentToRealmGeneratedKey(component *component, realmId string) (*RealmGeneratedKey, error) {
+	active, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("active"))
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("enabled"))
+	if err != nil {
+		return nil, err
+	}
+
+	priority := 0 // Default priority
+	if component.getConfig("priority") != "" {
+		priority, err = strconv.Atoi(component.getConfig("priority"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	config := make(map[string]string)
+	for key := range component.Config {
+		if key != "active" && key != "enabled" && key != "priority" {
+			config[key] = component.getConfig(key)
+		}
+	}
+
+	realmKey := &RealmGeneratedKey{
+		Id:         component.Id,
+		Name:       component.Name,
+		RealmId:    realmId,
+		ProviderId: component.ProviderId,
+
+		Active:   active,
+		Enabled:  enabled,
+		Priority: priority,
+		Config:   config,
+	}
+
+	return realmKey, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmGeneratedKey(ctx context.Context, realmKey *RealmGeneratedKey) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", realmKey.RealmId), convertFromRealmGeneratedKeyToComponent(realmKey))
+	if err != nil {
+		return err
+	}
+
+	realmKey.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmGeneratedKey(ctx context.Context, realmId, id string) (*RealmGeneratedKey, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmGeneratedKey(component, realmId)
+}
+
+// UpdateRealmGeneratedKey updates the flags and priority of the key. The generated key material is kept by Keycloak
+// because it is stored in the configuration of the component, which is merged with the configuration that is sent.
+func (keycloakClient *KeycloakClient) UpdateRealmGeneratedKey(ctx context.Context, realmKey *RealmGeneratedKey) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", realmKey.RealmId, realmKey.Id), convertFromRealmGeneratedKeyToComponent(realmKey))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmGeneratedKey(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
+
+// GetRealmKeyIds returns the kid of the keys of the realm, by the ID of the component that provides them.
+func (keycloakClient *KeycloakClient) GetRealmKeyIds(ctx context.Context, realmId string) (map[string]string, error) {
+	keys, err := keycloakClient.GetRealmKeys(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	kids := make(map[string]string)
+	for _, key := range keys.Keys {
+		if key.ProviderId != nil && key.Kid != nil {
+			kids[*key.ProviderId] = *key.Kid
+		}
+	}
+
+	return kids, nil
+}
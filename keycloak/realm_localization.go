@@ -0,0 +1,71 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// GetRealmLocalizationTexts returns the texts that override the message bundles of the given locale within the realm.
+// When useRealmDefaultLocaleFallback is true, the texts of the realm's default locale are included for keys that are not
+// overridden for this locale.
+func (keycloakClient *KeycloakClient) GetRealmLocalizationTexts(ctx context.Context, realmId, locale string, useRealmDefaultLocaleFallback bool) (map[string]string, error) {
+	var texts map[string]string
+
+	var params map[string]string
+	if useRealmDefaultLocaleFallback {
+		params = map[string]string{
+			"useRealmDefaultLocaleFallback": "true",
+		}
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/localization/%s", realmId, url.PathEscape(locale)), &texts, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if texts == nil {
+		texts = map[string]string{}
+	}
+
+	return texts, nil
+}
+
+// GetRealmEffectiveLocalizationTexts returns the texts that are shown for the given locale within the realm: the message
+// bundle of the theme, merged with the texts that override it within the realm.
+func (keycloakClient *KeycloakClient) GetRealmEffectiveLocalizationTexts(ctx context.Context, realmId, theme, themeType, locale string) (map[string]string, error) {
+	var texts map[string]string
+
+	params := map[string]string{
+		"theme":     theme,
+		"themeType": themeType,
+		"locale":    locale,
+		"source":    "false",
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/ui-ext/effective-message-bundle", realmId), &texts, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if texts == nil {
+		texts = map[string]string{}
+	}
+
+	return texts, nil
+}
+
+// UpdateRealmLocalizationTexts creates or updates the given texts, and keeps any other texts of the locale.
+func (keycloakClient *KeycloakClient) UpdateRealmLocalizationTexts(ctx context.Context, realmId, locale string, texts map[string]string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/localization/%s", realmId, url.PathEscape(locale)), texts)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmLocalizationText(ctx context.Context, realmId, locale, key string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/localization/%s/%s", realmId, url.PathEscape(locale), url.PathEscape(key)), nil)
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmLocalizationTexts(ctx context.Context, realmId, locale string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/localization/%s", realmId, url.PathEscape(locale)), nil)
+}
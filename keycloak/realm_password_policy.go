@@ -0,0 +1,65 @@
+package keycloak
+
+import (
+	"sort"
+	"strings"
+)
+
+// passwordPolicyUndefinedValue is how Keycloak, and the admin console, represent a policy without a value, such as
+// notUsername(undefined).
+const passwordPolicyUndefinedValue = "undefined"
+
+// PasswordPolicyRule is a single policy of the password policy of a realm, such as length(12).
+type PasswordPolicyRule struct {
+	Type  string
+	Value string
+}
+
+// ParsePasswordPolicy splits the password policy of a realm, such as "length(12) and notUsername(undefined)", into its
+// rules. A rule without a value gets an empty value.
+func ParsePasswordPolicy(passwordPolicy string) []PasswordPolicyRule {
+	var rules []PasswordPolicyRule
+
+	for _, policy := range strings.Split(passwordPolicy, " and ") {
+		policy = strings.TrimSpace(policy)
+		if policy == "" {
+			continue
+		}
+
+		rule := PasswordPolicyRule{
+			Type: policy,
+		}
+
+		if start := strings.Index(policy, "("); start != -1 && strings.HasSuffix(policy, ")") {
+			rule.Type = policy[:start]
+			rule.Value = policy[start+1 : len(policy)-1]
+		}
+
+		if rule.Value == passwordPolicyUndefinedValue {
+			rule.Value = ""
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// FormatPasswordPolicy joins the rules into the password policy of a realm. The rules are sorted, so that the same rules
+// always result in the same password policy.
+func FormatPasswordPolicy(rules []PasswordPolicyRule) string {
+	var policies []string
+
+	for _, rule := range rules {
+		value := rule.Value
+		if value == "" {
+			value = passwordPolicyUndefinedValue
+		}
+
+		policies = append(policies, rule.Type+"("+value+")")
+	}
+
+	sort.Strings(policies)
+
+	return strings.Join(policies, " and ")
+}
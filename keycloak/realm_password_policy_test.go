@@ -0,0 +1,44 @@
+package keycloak
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePasswordPolicy(t *testing.T) {
+	rules := ParsePasswordPolicy("length(12) and notUsername(undefined) and regexPattern(^(a|b)+$) and digits")
+
+	expected := []PasswordPolicyRule{
+		{Type: "length", Value: "12"},
+		{Type: "notUsername"},
+		{Type: "regexPattern", Value: "^(a|b)+$"},
+		{Type: "digits"},
+	}
+
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("expected %v, got %v", expected, rules)
+	}
+
+	if rules := ParsePasswordPolicy(""); len(rules) != 0 {
+		t.Errorf("expected no rules for an empty password policy, got %v", rules)
+	}
+}
+
+func TestFormatPasswordPolicy(t *testing.T) {
+	passwordPolicy := FormatPasswordPolicy([]PasswordPolicyRule{
+		{Type: "notUsername"},
+		{Type: "length", Value: "12"},
+	})
+
+	if passwordPolicy != "length(12) and notUsername(undefined)" {
+		t.Errorf("unexpected password policy %q", passwordPolicy)
+	}
+
+	if !reflect.DeepEqual(ParsePasswordPolicy(passwordPolicy), []PasswordPolicyRule{{Type: "length", Value: "12"}, {Type: "notUsername"}}) {
+		t.Errorf("expected the formatted password policy to parse into the same rules")
+	}
+
+	if passwordPolicy := FormatPasswordPolicy(nil); passwordPolicy != "" {
+		t.Errorf("expected an empty password policy without rules, got %q", passwordPolicy)
+	}
+}
@@ -0,0 +1,68 @@
+package keycloak
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Realm restrictions limit the realms that the client is allowed to manage. They are configured with the provider's
+// allowed_realms and denied_realms attributes, which accept glob patterns such as "tenant-*". A realm is manageable
+// when it does not match any denied pattern, and either no allowed patterns are configured or it matches at least one.
+
+func (keycloakClient *KeycloakClient) SetRealmRestrictions(allowedRealms, deniedRealms []string) error {
+	for _, pattern := range append(append([]string{}, allowedRealms...), deniedRealms...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid realm pattern \"%s\": %v", pattern, err)
+		}
+	}
+
+	keycloakClient.allowedRealms = allowedRealms
+	keycloakClient.deniedRealms = deniedRealms
+
+	return nil
+}
+
+// RealmIsManageable returns an error if the given realm is excluded by the configured realm restrictions.
+func (keycloakClient *KeycloakClient) RealmIsManageable(realm string) error {
+	for _, pattern := range keycloakClient.deniedRealms {
+		if matched, _ := path.Match(pattern, realm); matched {
+			return fmt.Errorf("realm \"%s\" cannot be managed by this provider because it matches the denied_realms pattern \"%s\"", realm, pattern)
+		}
+	}
+
+	if len(keycloakClient.allowedRealms) == 0 {
+		return nil
+	}
+
+	for _, pattern := range keycloakClient.allowedRealms {
+		if matched, _ := path.Match(pattern, realm); matched {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("realm \"%s\" cannot be managed by this provider because it does not match any of the allowed_realms patterns: %s", realm, strings.Join(keycloakClient.allowedRealms, ", "))
+}
+
+// checkRealmRestrictions is called with the path of every admin API request, which is relative to /admin.
+func (keycloakClient *KeycloakClient) checkRealmRestrictions(requestPath string) error {
+	if len(keycloakClient.allowedRealms) == 0 && len(keycloakClient.deniedRealms) == 0 {
+		return nil
+	}
+
+	if !strings.HasPrefix(requestPath, "/realms/") {
+		return nil
+	}
+
+	realm := strings.TrimPrefix(requestPath, "/realms/")
+	if i := strings.IndexAny(realm, "/?"); i != -1 {
+		realm = realm[:i]
+	}
+
+	if unescaped, err := url.PathUnescape(realm); err == nil {
+		realm = unescaped
+	}
+
+	return keycloakClient.RealmIsManageable(realm)
+}
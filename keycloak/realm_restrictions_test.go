@@ -0,0 +1,66 @@
+package keycloak
+
+import (
+	"testing"
+)
+
+func TestRealmIsManageable(t *testing.T) {
+	keycloakClient := &KeycloakClient{}
+
+	err := keycloakClient.SetRealmRestrictions([]string{"tenant-*", "shared"}, []string{"tenant-prod-*"})
+	if err != nil {
+		t.Fatalf("expected realm restrictions to be valid, got %s", err)
+	}
+
+	for realm, manageable := range map[string]bool{
+		"tenant-a":        true,
+		"shared":          true,
+		"tenant-prod-a":   false,
+		"master":          false,
+		"shared-tenant-a": false,
+	} {
+		err := keycloakClient.RealmIsManageable(realm)
+		if manageable && err != nil {
+			t.Errorf("expected realm %s to be manageable, got %s", realm, err)
+		}
+		if !manageable && err == nil {
+			t.Errorf("expected realm %s not to be manageable", realm)
+		}
+	}
+}
+
+func TestRealmRestrictionsRequestPaths(t *testing.T) {
+	keycloakClient := &KeycloakClient{}
+
+	err := keycloakClient.SetRealmRestrictions(nil, []string{"master"})
+	if err != nil {
+		t.Fatalf("expected realm restrictions to be valid, got %s", err)
+	}
+
+	for path, allowed := range map[string]bool{
+		"/serverinfo":                true,
+		"/realms":                    true,
+		"/realms/tenant":             true,
+		"/realms/tenant/clients":     true,
+		"/realms/master":             false,
+		"/realms/master/clients/abc": false,
+		"/realms/master?briefRepresentation=true": false,
+		"/realms/%6Daster/users":                  false,
+	} {
+		err := keycloakClient.checkRealmRestrictions(path)
+		if allowed && err != nil {
+			t.Errorf("expected request to %s to be allowed, got %s", path, err)
+		}
+		if !allowed && err == nil {
+			t.Errorf("expected request to %s to be denied", path)
+		}
+	}
+}
+
+func TestRealmRestrictionsInvalidPattern(t *testing.T) {
+	keycloakClient := &KeycloakClient{}
+
+	if err := keycloakClient.SetRealmRestrictions([]string{"tenant-["}, nil); err == nil {
+		t.Fatalf("expected invalid realm pattern to return an error")
+	}
+}
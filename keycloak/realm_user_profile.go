@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 )
 
 type RealmUserProfilePermissions struct {
@@ -26,6 +27,7 @@ type RealmUserProfileAttribute struct {
 	Annotations map[string]interface{}                      `json:"annotations,omitempty"`
 	DisplayName string                                      `json:"displayName,omitempty"`
 	Group       string                                      `json:"group,omitempty"`
+	Multivalued bool                                        `json:"multivalued,omitempty"`
 	Name        string                                      `json:"name"`
 	Permissions *RealmUserProfilePermissions                `json:"permissions,omitempty"`
 	Required    *RealmUserProfileRequired                   `json:"required,omitempty"`
@@ -41,15 +43,18 @@ type RealmUserProfileGroup struct {
 }
 
 type RealmUserProfile struct {
-	Attributes []*RealmUserProfileAttribute `json:"attributes"`
-	Groups     []*RealmUserProfileGroup     `json:"groups,omitempty"`
+	Attributes               []*RealmUserProfileAttribute `json:"attributes"`
+	Groups                   []*RealmUserProfileGroup     `json:"groups,omitempty"`
+	UnmanagedAttributePolicy string                       `json:"unmanagedAttributePolicy,omitempty"`
 }
 
 func (keycloakClient *KeycloakClient) UpdateRealmUserProfile(ctx context.Context, realmId string, realmUserProfile *RealmUserProfile) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), realmUserProfile)
 }
 
-func (keycloakClient *KeycloakClient) GetRealmUserProfile(ctx context.Context, realmId string) (*RealmUserProfile, error) {
+// getRealmUserProfile returns the user profile as it is stored by Keycloak, without encoding the values of validator
+// configs and annotations, so that it can be sent back unchanged.
+func (keycloakClient *KeycloakClient) getRealmUserProfile(ctx context.Context, realmId string) (*RealmUserProfile, error) {
 	var realmUserProfile RealmUserProfile
 	body, err := keycloakClient.getRaw(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), nil)
 	if err != nil {
@@ -65,50 +70,172 @@ func (keycloakClient *KeycloakClient) GetRealmUserProfile(ctx context.Context, r
 		return nil, err
 	}
 
+	return &realmUserProfile, nil
+}
+
+// encodeRealmUserProfileValue encodes lists, objects, numbers and booleans as JSON strings, because validator configs
+// and annotations are maps of strings in Terraform.
+func encodeRealmUserProfileValue(v interface{}) interface{} {
+	if _, ok := v.(string); ok || v == nil {
+		return v
+	}
+
+	tmp, _ := json.Marshal(v)
+	return string(tmp)
+}
+
+func encodeRealmUserProfileValues(values map[string]interface{}) {
+	for k, v := range values {
+		values[k] = encodeRealmUserProfileValue(v)
+	}
+}
+
+func (keycloakClient *KeycloakClient) GetRealmUserProfile(ctx context.Context, realmId string) (*RealmUserProfile, error) {
+	realmUserProfile, err := keycloakClient.getRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, attr := range realmUserProfile.Attributes {
-		if attr.Validations != nil {
-			for name, config := range attr.Validations {
-
-				c := make(map[string]interface{})
-				for k, v := range config {
-					if _, ok := v.([]interface{}); ok {
-						tmp, _ := json.Marshal(v)
-						c[k] = string(tmp)
-					} else {
-						c[k] = v
-					}
-				}
-
-				attr.Validations[name] = c
-			}
+		for _, config := range attr.Validations {
+			encodeRealmUserProfileValues(config)
 		}
-		if attr.Annotations != nil {
-			for k, v := range attr.Annotations {
 
-				if _, ok := v.(map[string]interface{}); ok {
-					tmp, _ := json.Marshal(v)
-					attr.Annotations[k] = string(tmp)
-				} else {
-					attr.Annotations[k] = v
-				}
+		encodeRealmUserProfileValues(attr.Annotations)
+	}
+
+	for _, group := range realmUserProfile.Groups {
+		encodeRealmUserProfileValues(group.Annotations)
+	}
+
+	return realmUserProfile, nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmUserProfileAttribute(ctx context.Context, realmId, name string) (*RealmUserProfileAttribute, error) {
+	realmUserProfile, err := keycloakClient.GetRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
 
-			}
+	for _, attribute := range realmUserProfile.Attributes {
+		if attribute.Name == name {
+			return attribute, nil
 		}
 	}
 
-	for _, attr := range realmUserProfile.Groups {
-		if attr.Annotations != nil {
-			for k, v := range attr.Annotations {
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("user profile attribute %s does not exist in realm %s", name, realmId),
+	}
+}
 
-				if _, ok := v.(map[string]interface{}); ok {
-					tmp, _ := json.Marshal(v)
-					attr.Annotations[k] = string(tmp)
-				} else {
-					attr.Annotations[k] = v
-				}
+// SetRealmUserProfileAttribute replaces the attribute with the same name in the user profile, or adds it when there is
+// none. All other entries of the user profile are kept.
+func (keycloakClient *KeycloakClient) SetRealmUserProfileAttribute(ctx context.Context, realmId string, attribute *RealmUserProfileAttribute) error {
+	realmUserProfile, err := keycloakClient.getRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return err
+	}
 
-			}
+	found := false
+	for i, existing := range realmUserProfile.Attributes {
+		if existing.Name == attribute.Name {
+			realmUserProfile.Attributes[i] = attribute
+			found = true
 		}
 	}
-	return &realmUserProfile, nil
+
+	if !found {
+		realmUserProfile.Attributes = append(realmUserProfile.Attributes, attribute)
+	}
+
+	return keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
+}
+
+func (keycloakClient *KeycloakClient) RemoveRealmUserProfileAttribute(ctx context.Context, realmId, name string) error {
+	realmUserProfile, err := keycloakClient.getRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	attributes := make([]*RealmUserProfileAttribute, 0)
+	for _, attribute := range realmUserProfile.Attributes {
+		if attribute.Name != name {
+			attributes = append(attributes, attribute)
+		}
+	}
+	realmUserProfile.Attributes = attributes
+
+	return keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
+}
+
+func (keycloakClient *KeycloakClient) GetRealmUserProfileGroup(ctx context.Context, realmId, name string) (*RealmUserProfileGroup, error) {
+	realmUserProfile, err := keycloakClient.GetRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range realmUserProfile.Groups {
+		if group.Name == name {
+			return group, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("user profile group %s does not exist in realm %s", name, realmId),
+	}
+}
+
+// SetRealmUserProfileGroup replaces the group with the same name in the user profile, or adds it when there is none.
+// All other entries of the user profile are kept.
+func (keycloakClient *KeycloakClient) SetRealmUserProfileGroup(ctx context.Context, realmId string, group *RealmUserProfileGroup) error {
+	realmUserProfile, err := keycloakClient.getRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i, existing := range realmUserProfile.Groups {
+		if existing.Name == group.Name {
+			realmUserProfile.Groups[i] = group
+			found = true
+		}
+	}
+
+	if !found {
+		realmUserProfile.Groups = append(realmUserProfile.Groups, group)
+	}
+
+	return keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
+}
+
+func (keycloakClient *KeycloakClient) RemoveRealmUserProfileGroup(ctx context.Context, realmId, name string) error {
+	realmUserProfile, err := keycloakClient.getRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	groups := make([]*RealmUserProfileGroup, 0)
+	for _, group := range realmUserProfile.Groups {
+		if group.Name != name {
+			groups = append(groups, group)
+		}
+	}
+	realmUserProfile.Groups = groups
+
+	return keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
+}
+
+// SetRealmUserProfileUnmanagedAttributePolicy only changes the unmanaged attribute policy of the user profile. An empty
+// policy disables unmanaged attributes. All attributes and groups of the user profile are kept.
+func (keycloakClient *KeycloakClient) SetRealmUserProfileUnmanagedAttributePolicy(ctx context.Context, realmId, policy string) error {
+	realmUserProfile, err := keycloakClient.getRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	realmUserProfile.UnmanagedAttributePolicy = policy
+
+	return keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
 }
@@ -0,0 +1,84 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Keycloak's admin API replaces a realm or client with the representation sent in a PUT request, and the structs in this
+// package only model the fields that this provider manages. To avoid resetting fields that are managed elsewhere, such
+// as flow bindings, the default role or attributes set by other tooling, updates are applied on top of the live
+// representation instead.
+//
+// Fields that are null or omitted in the update keep their live value, as do fields for which includeField returns false
+// when it is given. Attributes are merged key by key, and the given removed attributes are deleted from the merged
+// representation.
+func (keycloakClient *KeycloakClient) mergeWithLiveRepresentation(ctx context.Context, path string, update interface{}, includeField func(field string) bool, removedAttributes []string) (map[string]interface{}, error) {
+	body, err := keycloakClient.getRaw(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var live map[string]interface{}
+	if err := json.Unmarshal(body, &live); err != nil {
+		return nil, err
+	}
+
+	updateJson, err := json.Marshal(update)
+	if err != nil {
+		return nil, err
+	}
+
+	var updateRepresentation map[string]interface{}
+	if err := json.Unmarshal(updateJson, &updateRepresentation); err != nil {
+		return nil, err
+	}
+
+	if includeField != nil {
+		for field := range updateRepresentation {
+			if !includeField(field) {
+				delete(updateRepresentation, field)
+			}
+		}
+	}
+
+	return mergeRepresentations(live, updateRepresentation, removedAttributes), nil
+}
+
+func mergeRepresentations(live, update map[string]interface{}, removedAttributes []string) map[string]interface{} {
+	if live == nil {
+		live = map[string]interface{}{}
+	}
+
+	for key, value := range update {
+		if value == nil {
+			continue
+		}
+
+		if key == "attributes" {
+			liveAttributes, _ := live[key].(map[string]interface{})
+			if liveAttributes == nil {
+				liveAttributes = map[string]interface{}{}
+			}
+
+			if updateAttributes, ok := value.(map[string]interface{}); ok {
+				for attribute, attributeValue := range updateAttributes {
+					liveAttributes[attribute] = attributeValue
+				}
+			}
+
+			live[key] = liveAttributes
+			continue
+		}
+
+		live[key] = value
+	}
+
+	if attributes, ok := live["attributes"].(map[string]interface{}); ok {
+		for _, attribute := range removedAttributes {
+			delete(attributes, attribute)
+		}
+	}
+
+	return live
+}
@@ -0,0 +1,44 @@
+package keycloak
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeRepresentations(t *testing.T) {
+	live := map[string]interface{}{
+		"realm":       "test",
+		"enabled":     true,
+		"browserFlow": "custom browser",
+		"attributes": map[string]interface{}{
+			"unmanaged": "kept",
+			"managed":   "old",
+			"removed":   "value",
+		},
+	}
+
+	update := map[string]interface{}{
+		"realm":       "test",
+		"enabled":     false,
+		"browserFlow": nil,
+		"attributes": map[string]interface{}{
+			"managed": "new",
+		},
+	}
+
+	expected := map[string]interface{}{
+		"realm":       "test",
+		"enabled":     false,
+		"browserFlow": "custom browser",
+		"attributes": map[string]interface{}{
+			"unmanaged": "kept",
+			"managed":   "new",
+		},
+	}
+
+	merged := mergeRepresentations(live, update, []string{"removed"})
+
+	if !reflect.DeepEqual(expected, merged) {
+		t.Fatalf("expected merged representation %v, got %v", expected, merged)
+	}
+}
@@ -112,8 +112,8 @@ func (keycloakClient *KeycloakClient) ValidateRequiredAction(ctx context.Context
 		return fmt.Errorf("validation error: a 'default' required action should be enabled, set 'defaultAction' to 'false' or set 'enabled' to 'true'")
 	}
 
-	if !serverInfo.providerInstalled("required-action", requiredAction.Alias) {
-		return fmt.Errorf("validation error: required action \"%s\" does not exist on the server, installed providers: %s", requiredAction.Alias, serverInfo.getInstalledProvidersNames("required-action"))
+	if !serverInfo.ProviderIsInstalled("required-action", requiredAction.Alias) {
+		return fmt.Errorf("validation error: required action \"%s\" does not exist on the server, installed providers: %s", requiredAction.Alias, serverInfo.GetInstalledProvidersNames("required-action"))
 	}
 
 	return nil
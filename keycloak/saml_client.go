@@ -118,11 +118,20 @@ func (keycloakClient *KeycloakClient) GetSamlClientByClientId(ctx context.Contex
 	return &client, nil
 }
 
+// UpdateSamlClient merges the given client into the live client representation, so attributes that are not modeled by
+// SamlClientAttributes are kept.
 func (keycloakClient *KeycloakClient) UpdateSamlClient(ctx context.Context, client *SamlClient) error {
 	client.Protocol = "saml"
 	client.ClientAuthenticatorType = "client-secret"
 
-	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s", client.RealmId, client.Id), client)
+	path := fmt.Sprintf("/realms/%s/clients/%s", client.RealmId, client.Id)
+
+	mergedClient, err := keycloakClient.mergeWithLiveRepresentation(ctx, path, client, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, path, mergedClient)
 }
 
 func (keycloakClient *KeycloakClient) DeleteSamlClient(ctx context.Context, realmId, id string) error {
@@ -1,6 +1,9 @@
 package keycloak
 
-import "context"
+import (
+	"context"
+	"sort"
+)
 
 type SystemInfo struct {
 	ServerVersion string `json:"version"`
@@ -23,11 +26,57 @@ type Theme struct {
 	Locales []string `json:"locales,omitempty"`
 }
 
+type Feature struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+type PasswordPolicyType struct {
+	Id                string `json:"id"`
+	DisplayName       string `json:"displayName"`
+	ConfigType        string `json:"configType"`
+	DefaultValue      string `json:"defaultValue"`
+	MultipleSupported bool   `json:"multipleSupported"`
+}
+
 type ServerInfo struct {
-	SystemInfo     SystemInfo                 `json:"systemInfo"`
-	ComponentTypes map[string][]ComponentType `json:"componentTypes"`
-	ProviderTypes  map[string]ProviderType    `json:"providers"`
-	Themes         map[string][]Theme         `json:"themes"`
+	SystemInfo       SystemInfo                 `json:"systemInfo"`
+	ComponentTypes   map[string][]ComponentType `json:"componentTypes"`
+	ProviderTypes    map[string]ProviderType    `json:"providers"`
+	Themes           map[string][]Theme         `json:"themes"`
+	Features         []Feature                  `json:"features"`
+	PasswordPolicies []PasswordPolicyType       `json:"passwordPolicies"`
+}
+
+// FeatureIsEnabled reports whether the server has the given feature, such as ORGANIZATION, enabled. Servers that are too
+// old to report their features never have any feature enabled.
+func (serverInfo *ServerInfo) FeatureIsEnabled(featureName string) bool {
+	for _, feature := range serverInfo.Features {
+		if feature.Name == featureName {
+			return feature.Enabled
+		}
+	}
+
+	return false
+}
+
+func (serverInfo *ServerInfo) PasswordPolicyIsAvailable(passwordPolicyId string) bool {
+	for _, passwordPolicy := range serverInfo.PasswordPolicies {
+		if passwordPolicy.Id == passwordPolicyId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (serverInfo *ServerInfo) GetPasswordPolicyIds() []string {
+	var ids []string
+	for _, passwordPolicy := range serverInfo.PasswordPolicies {
+		ids = append(ids, passwordPolicy.Id)
+	}
+	sort.Strings(ids)
+	return ids
 }
 
 func (serverInfo *ServerInfo) ThemeIsInstalled(t, themeName string) bool {
@@ -54,16 +103,17 @@ func (serverInfo *ServerInfo) ComponentTypeIsInstalled(componentType, componentT
 	return false
 }
 
-func (serverInfo *ServerInfo) getInstalledProvidersNames(providerType string) []string {
+func (serverInfo *ServerInfo) GetInstalledProvidersNames(providerType string) []string {
 	providers := serverInfo.ProviderTypes[providerType].Providers
 	keys := make([]string, 0, len(providers))
 	for p := range providers {
 		keys = append(keys, p)
 	}
+	sort.Strings(keys)
 	return keys
 }
 
-func (serverInfo *ServerInfo) providerInstalled(providerType, providerName string) bool {
+func (serverInfo *ServerInfo) ProviderIsInstalled(providerType, providerName string) bool {
 	providers := serverInfo.ProviderTypes[providerType].Providers
 	for p := range providers {
 		if p == providerName {
@@ -73,6 +123,24 @@ func (serverInfo *ServerInfo) providerInstalled(providerType, providerName strin
 	return false
 }
 
+func (serverInfo *ServerInfo) GetInstalledThemeNames(t string) []string {
+	var names []string
+	for _, theme := range serverInfo.Themes[t] {
+		names = append(names, theme.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (serverInfo *ServerInfo) GetInstalledComponentTypeIds(componentType string) []string {
+	var ids []string
+	for _, c := range serverInfo.ComponentTypes[componentType] {
+		ids = append(ids, c.Id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
 func (keycloakClient *KeycloakClient) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
 	var serverInfo ServerInfo
 
@@ -83,3 +151,23 @@ func (keycloakClient *KeycloakClient) GetServerInfo(ctx context.Context) (*Serve
 
 	return &serverInfo, nil
 }
+
+// GetCachedServerInfo only retrieves the server info once per client. The server info document is large, and it is
+// used to validate many resources during a single plan.
+func (keycloakClient *KeycloakClient) GetCachedServerInfo(ctx context.Context) (*ServerInfo, error) {
+	keycloakClient.serverInfoMutex.Lock()
+	defer keycloakClient.serverInfoMutex.Unlock()
+
+	if keycloakClient.serverInfo != nil {
+		return keycloakClient.serverInfo, nil
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keycloakClient.serverInfo = serverInfo
+
+	return serverInfo, nil
+}
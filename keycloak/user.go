@@ -3,6 +3,10 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 type FederatedIdentity struct {
@@ -23,9 +27,11 @@ type User struct {
 	FirstName           string              `json:"firstName"`
 	LastName            string              `json:"lastName"`
 	Enabled             bool                `json:"enabled"`
+	FederationLink      string              `json:"federationLink,omitempty"`
 	Attributes          map[string][]string `json:"attributes"`
 	FederatedIdentities FederatedIdentities `json:"federatedIdentities"`
 	RequiredActions     []string            `json:"requiredActions"`
+	Credentials         []*UserCredential   `json:"credentials,omitempty"`
 }
 
 type PasswordCredentials struct {
@@ -44,8 +50,10 @@ func (keycloakClient *KeycloakClient) NewUser(ctx context.Context, user *User) e
 		FirstName:       user.FirstName,
 		LastName:        user.LastName,
 		Enabled:         user.Enabled,
+		FederationLink:  user.FederationLink,
 		Attributes:      user.Attributes,
 		RequiredActions: user.RequiredActions,
+		Credentials:     user.Credentials,
 	}
 	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users", user.RealmId), newUser)
 	if err != nil {
@@ -55,7 +63,7 @@ func (keycloakClient *KeycloakClient) NewUser(ctx context.Context, user *User) e
 	user.Id = getIdFromLocationHeader(location)
 
 	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
+		err := keycloakClient.NewUserFederatedIdentity(ctx, user.RealmId, user.Id, federatedIdentity)
 		if err != nil {
 			return err
 		}
@@ -107,29 +115,7 @@ func (keycloakClient *KeycloakClient) GetUser(ctx context.Context, realmId, id s
 }
 
 func (keycloakClient *KeycloakClient) UpdateUser(ctx context.Context, user *User) error {
-	err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s", user.RealmId, user.Id), user)
-	if err != nil {
-		return err
-	}
-
-	var federatedIdentities []*FederatedIdentity
-	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", user.RealmId, user.Id), &federatedIdentities, nil)
-	if err != nil {
-		return err
-	}
-
-	for _, federatedIdentity := range federatedIdentities {
-		keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), nil)
-	}
-
-	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s", user.RealmId, user.Id), user)
 }
 
 func (keycloakClient *KeycloakClient) DeleteUser(ctx context.Context, realmId, id string) error {
@@ -163,6 +149,80 @@ func (keycloakClient *KeycloakClient) GetUserByUsername(ctx context.Context, rea
 	return nil, nil
 }
 
+// UserQuery holds the search parameters of a query for users. Empty parameters are not sent, and Enabled and
+// EmailVerified are only sent when they are not nil. Attributes are sent as the q parameter, which requires Keycloak 15.
+type UserQuery struct {
+	Search        string
+	Username      string
+	Email         string
+	FirstName     string
+	LastName      string
+	Exact         bool
+	Enabled       *bool
+	EmailVerified *bool
+	IdpAlias      string
+	Attributes    map[string]string
+	Max           int
+}
+
+const userQueryPageSize = 100
+
+// SearchUsers returns the users that match the query, requesting one page of users after the other until all users
+// are returned or the maximum number of users is reached. A Max of 0 returns all users.
+func (keycloakClient *KeycloakClient) SearchUsers(ctx context.Context, realmId string, userQuery *UserQuery) ([]*User, error) {
+	query := url.Values{}
+	addQueryValue(query, "search", userQuery.Search)
+	addQueryValue(query, "username", userQuery.Username)
+	addQueryValue(query, "email", userQuery.Email)
+	addQueryValue(query, "firstName", userQuery.FirstName)
+	addQueryValue(query, "lastName", userQuery.LastName)
+	addQueryValue(query, "idpAlias", userQuery.IdpAlias)
+	if userQuery.Exact {
+		query.Add("exact", "true")
+	}
+	if userQuery.Enabled != nil {
+		query.Add("enabled", strconv.FormatBool(*userQuery.Enabled))
+	}
+	if userQuery.EmailVerified != nil {
+		query.Add("emailVerified", strconv.FormatBool(*userQuery.EmailVerified))
+	}
+	if len(userQuery.Attributes) != 0 {
+		var attributes []string
+		for key, value := range userQuery.Attributes {
+			attributes = append(attributes, fmt.Sprintf("%s:%s", key, value))
+		}
+		sort.Strings(attributes)
+
+		query.Add("q", strings.Join(attributes, " "))
+	}
+
+	var users []*User
+	for {
+		pageSize := userQueryPageSize
+		if userQuery.Max != 0 && userQuery.Max-len(users) < pageSize {
+			pageSize = userQuery.Max - len(users)
+		}
+
+		query.Set("first", strconv.Itoa(len(users)))
+		query.Set("max", strconv.Itoa(pageSize))
+
+		var page []*User
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users?%s", realmId, query.Encode()), &page, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range page {
+			user.RealmId = realmId
+		}
+		users = append(users, page...)
+
+		if len(page) < pageSize || len(users) == userQuery.Max {
+			return users, nil
+		}
+	}
+}
+
 func (keycloakClient *KeycloakClient) GetUserGroups(ctx context.Context, realmId, userId string) ([]*Group, error) {
 	var groups []*Group
 	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/groups/", realmId, userId), &groups, nil)
@@ -0,0 +1,131 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	UserCredentialTypePassword = "password"
+	UserCredentialTypeOtp      = "otp"
+)
+
+// UserCredential is a credential in the format Keycloak uses for exports and imports. SecretData and CredentialData
+// are JSON documents that are stored as is, which allows importing password hashes and OTP secrets from another system.
+type UserCredential struct {
+	Id             string `json:"id,omitempty"`
+	Type           string `json:"type"`
+	UserLabel      string `json:"userLabel,omitempty"`
+	CreatedDate    int64  `json:"createdDate,omitempty"`
+	SecretData     string `json:"secretData,omitempty"`
+	CredentialData string `json:"credentialData,omitempty"`
+	Temporary      bool   `json:"temporary,omitempty"`
+}
+
+type UserHashedPassword struct {
+	Value          string
+	Salt           string
+	Algorithm      string
+	HashIterations int
+}
+
+type UserOtpSecret struct {
+	Secret    string
+	SubType   string
+	Algorithm string
+	Digits    int
+	Period    int
+	Counter   int
+}
+
+type userPasswordSecretData struct {
+	Value                string              `json:"value"`
+	Salt                 string              `json:"salt"`
+	AdditionalParameters map[string][]string `json:"additionalParameters"`
+}
+
+type userPasswordCredentialData struct {
+	HashIterations       int                 `json:"hashIterations"`
+	Algorithm            string              `json:"algorithm"`
+	AdditionalParameters map[string][]string `json:"additionalParameters"`
+}
+
+type userOtpSecretData struct {
+	Value string `json:"value"`
+}
+
+type userOtpCredentialData struct {
+	SubType   string `json:"subType"`
+	Digits    int    `json:"digits"`
+	Counter   int    `json:"counter"`
+	Period    int    `json:"period"`
+	Algorithm string `json:"algorithm"`
+}
+
+func NewUserHashedPasswordCredential(password *UserHashedPassword, userLabel string, temporary bool) (*UserCredential, error) {
+	secretData, err := json.Marshal(&userPasswordSecretData{
+		Value:                password.Value,
+		Salt:                 password.Salt,
+		AdditionalParameters: map[string][]string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credentialData, err := json.Marshal(&userPasswordCredentialData{
+		HashIterations:       password.HashIterations,
+		Algorithm:            password.Algorithm,
+		AdditionalParameters: map[string][]string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserCredential{
+		Type:           UserCredentialTypePassword,
+		UserLabel:      userLabel,
+		SecretData:     string(secretData),
+		CredentialData: string(credentialData),
+		Temporary:      temporary,
+	}, nil
+}
+
+func NewUserOtpCredential(otp *UserOtpSecret, userLabel string) (*UserCredential, error) {
+	secretData, err := json.Marshal(&userOtpSecretData{
+		Value: otp.Secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credentialData, err := json.Marshal(&userOtpCredentialData{
+		SubType:   otp.SubType,
+		Digits:    otp.Digits,
+		Counter:   otp.Counter,
+		Period:    otp.Period,
+		Algorithm: otp.Algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserCredential{
+		Type:           UserCredentialTypeOtp,
+		UserLabel:      userLabel,
+		SecretData:     string(secretData),
+		CredentialData: string(credentialData),
+	}, nil
+}
+
+// GetUserCredentials returns the credentials of a user. Keycloak never returns the secret data of a credential.
+func (keycloakClient *KeycloakClient) GetUserCredentials(ctx context.Context, realmId, userId string) ([]*UserCredential, error) {
+	var credentials []*UserCredential
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials", realmId, userId), &credentials, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials, nil
+}
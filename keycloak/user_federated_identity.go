@@ -0,0 +1,86 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (keycloakClient *KeycloakClient) GetUserFederatedIdentities(ctx context.Context, realmId, userId string) (FederatedIdentities, error) {
+	var federatedIdentities FederatedIdentities
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", realmId, userId), &federatedIdentities, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return federatedIdentities, nil
+}
+
+// GetUserFederatedIdentity returns the link of a user to an identity provider. Keycloak has no endpoint for a single
+// link, so all links of the user are requested.
+func (keycloakClient *KeycloakClient) GetUserFederatedIdentity(ctx context.Context, realmId, userId, identityProviderAlias string) (*FederatedIdentity, error) {
+	federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, federatedIdentity := range federatedIdentities {
+		if federatedIdentity.IdentityProvider == identityProviderAlias {
+			return federatedIdentity, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("user with id %s is not linked to identity provider %s", userId, identityProviderAlias),
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewUserFederatedIdentity(ctx context.Context, realmId, userId string, federatedIdentity *FederatedIdentity) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, federatedIdentity.IdentityProvider), federatedIdentity)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) DeleteUserFederatedIdentity(ctx context.Context, realmId, userId, identityProviderAlias string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, identityProviderAlias), nil)
+}
+
+// UpdateUserFederatedIdentities changes the links of a user to identity providers from the old links to the new links.
+// Links to identity providers that are in neither list are kept.
+func (keycloakClient *KeycloakClient) UpdateUserFederatedIdentities(ctx context.Context, realmId, userId string, oldFederatedIdentities, newFederatedIdentities FederatedIdentities) error {
+	for _, oldFederatedIdentity := range oldFederatedIdentities {
+		if newFederatedIdentities.contains(oldFederatedIdentity) {
+			continue
+		}
+
+		err := keycloakClient.DeleteUserFederatedIdentity(ctx, realmId, userId, oldFederatedIdentity.IdentityProvider)
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
+	}
+
+	for _, newFederatedIdentity := range newFederatedIdentities {
+		if oldFederatedIdentities.contains(newFederatedIdentity) {
+			continue
+		}
+
+		err := keycloakClient.NewUserFederatedIdentity(ctx, realmId, userId, newFederatedIdentity)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (federatedIdentities FederatedIdentities) contains(federatedIdentity *FederatedIdentity) bool {
+	for _, f := range federatedIdentities {
+		if *f == *federatedIdentity {
+			return true
+		}
+	}
+
+	return false
+}
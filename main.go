@@ -1,15 +1,83 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 	"github.com/mrparkers/terraform-provider-keycloak/provider"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		os.Exit(generate(os.Args[2:]))
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
 			return provider.KeycloakProvider(nil)
 		},
 	})
 }
+
+// generate writes Terraform configuration for an existing realm. The connection to Keycloak is configured with the same
+// environment variables that the provider uses, such as KEYCLOAK_URL, KEYCLOAK_CLIENT_ID and KEYCLOAK_CLIENT_SECRET.
+func generate(args []string) int {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s generate --realm <realm> [--output <file>]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes Terraform configuration and import blocks for an existing realm. The connection to Keycloak")
+		fmt.Fprintln(flags.Output(), "is configured with the same environment variables as the provider, such as KEYCLOAK_URL. Secrets are")
+		fmt.Fprintln(flags.Output(), "not exported: the ones that have to be set are read from variables that are declared in the output.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+
+	realm := flags.String("realm", "", "The name of the realm to generate configuration for")
+	output := flags.String("output", "", "The file to write the configuration to. Defaults to stdout")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *realm == "" {
+		fmt.Fprintln(os.Stderr, "Error: --realm is required")
+		flags.Usage()
+		return 2
+	}
+
+	ctx := context.Background()
+
+	keycloakProvider := provider.KeycloakProvider(nil)
+	diags := keycloakProvider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	if diags.HasError() {
+		for _, d := range diags {
+			fmt.Fprintf(os.Stderr, "Error: %s: %s\n", d.Summary, d.Detail)
+		}
+		return 1
+	}
+
+	configuration, err := provider.GenerateRealmConfiguration(ctx, keycloakProvider.Meta().(*keycloak.KeycloakClient), *realm)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(configuration)
+	} else {
+		err = os.WriteFile(*output, configuration, 0644)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	return 0
+}
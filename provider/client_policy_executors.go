@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type clientPolicyExecutorSetting struct {
+	key       string
+	valueType schema.ValueType
+}
+
+// The client policy executors whose configuration can be set with a typed block instead of the configuration map. Each
+// block is named after the executor, with dashes replaced by underscores, and each of its attributes is named after a
+// configuration key in the same way.
+var clientPolicyExecutorSettings = map[string][]clientPolicyExecutorSetting{
+	"pkce-enforcer": {
+		{key: "auto-configure", valueType: schema.TypeBool},
+	},
+	"holder-of-key-enforcer": {
+		{key: "auto-configure", valueType: schema.TypeBool},
+	},
+	"secure-client-authenticator": {
+		{key: "allowed-client-authenticators", valueType: schema.TypeList},
+		{key: "default-client-authenticator", valueType: schema.TypeString},
+	},
+	"secure-signature-algorithm": {
+		{key: "default-algorithm", valueType: schema.TypeString},
+	},
+	"secure-response-type": {
+		{key: "auto-configure", valueType: schema.TypeBool},
+		{key: "allow-token-response-type", valueType: schema.TypeBool},
+	},
+	"secure-request-object": {
+		{key: "verify-nbf", valueType: schema.TypeBool},
+		{key: "available-period", valueType: schema.TypeInt},
+		{key: "encryption-required", valueType: schema.TypeBool},
+	},
+	"secure-redirect-uris-enforcer": {
+		{key: "allow-ipv4-loopback-address", valueType: schema.TypeBool},
+		{key: "allow-ipv6-loopback-address", valueType: schema.TypeBool},
+		{key: "allow-private-use-uri-scheme", valueType: schema.TypeBool},
+		{key: "allow-http-scheme", valueType: schema.TypeBool},
+		{key: "allow-wildcard-context-path", valueType: schema.TypeBool},
+		{key: "allow-open-redirect", valueType: schema.TypeBool},
+		{key: "oauth-2-1-compliant", valueType: schema.TypeBool},
+		{key: "allow-permitted-domains", valueType: schema.TypeList},
+	},
+}
+
+func clientPolicyAttributeName(key string) string {
+	return strings.ReplaceAll(key, "-", "_")
+}
+
+func clientPolicyExecutorBlocksSchema() map[string]*schema.Schema {
+	blocks := make(map[string]*schema.Schema)
+	for executor, settings := range clientPolicyExecutorSettings {
+		settingsSchema := make(map[string]*schema.Schema)
+		for _, setting := range settings {
+			settingSchema := &schema.Schema{
+				Type:     setting.valueType,
+				Optional: true,
+			}
+
+			if setting.valueType == schema.TypeList {
+				settingSchema.Elem = &schema.Schema{Type: schema.TypeString}
+			}
+
+			settingsSchema[clientPolicyAttributeName(setting.key)] = settingSchema
+		}
+
+		blocks[clientPolicyAttributeName(executor)] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: fmt.Sprintf("The configuration of the %s executor.", executor),
+			Elem: &schema.Resource{
+				Schema: settingsSchema,
+			},
+		}
+	}
+
+	return blocks
+}
+
+// getClientPolicyExecutorConfigurationFromData returns the configuration of an executor from its typed block when the
+// executor has one, or from its configuration map otherwise.
+func getClientPolicyExecutorConfigurationFromData(executor map[string]interface{}) map[string]interface{} {
+	settings, ok := clientPolicyExecutorSettings[executor["executor"].(string)]
+	if !ok {
+		return getClientPolicyConfigurationFromData(executor["configuration"].(map[string]interface{}))
+	}
+
+	configuration := make(map[string]interface{})
+
+	block := executor[clientPolicyAttributeName(executor["executor"].(string))].([]interface{})
+	if len(block) == 0 || block[0] == nil {
+		return configuration
+	}
+
+	values := block[0].(map[string]interface{})
+	for _, setting := range settings {
+		value := values[clientPolicyAttributeName(setting.key)]
+
+		switch setting.valueType {
+		case schema.TypeBool:
+			configuration[setting.key] = value.(bool)
+		case schema.TypeInt:
+			if value.(int) != 0 {
+				configuration[setting.key] = value.(int)
+			}
+		case schema.TypeString:
+			if value.(string) != "" {
+				configuration[setting.key] = value.(string)
+			}
+		case schema.TypeList:
+			if len(value.([]interface{})) != 0 {
+				configuration[setting.key] = value.([]interface{})
+			}
+		}
+	}
+
+	return configuration
+}
+
+// setClientPolicyExecutorConfigurationData sets the configuration of an executor to its typed block when the executor
+// has one, or to its configuration map otherwise. Keycloak keeps the configuration as it was sent, so values are
+// accepted both as JSON values and as strings.
+func setClientPolicyExecutorConfigurationData(executorData map[string]interface{}, executor string, configuration map[string]interface{}) {
+	for blockExecutor := range clientPolicyExecutorSettings {
+		executorData[clientPolicyAttributeName(blockExecutor)] = []interface{}{}
+	}
+
+	settings, ok := clientPolicyExecutorSettings[executor]
+	if !ok {
+		executorData["configuration"] = getClientPolicyConfigurationData(configuration)
+		return
+	}
+
+	executorData["configuration"] = map[string]interface{}{}
+
+	if len(configuration) == 0 {
+		return
+	}
+
+	values := make(map[string]interface{})
+	for _, setting := range settings {
+		value := configuration[setting.key]
+
+		switch setting.valueType {
+		case schema.TypeBool:
+			switch v := value.(type) {
+			case bool:
+				values[clientPolicyAttributeName(setting.key)] = v
+			case string:
+				values[clientPolicyAttributeName(setting.key)], _ = strconv.ParseBool(v)
+			default:
+				values[clientPolicyAttributeName(setting.key)] = false
+			}
+		case schema.TypeInt:
+			switch v := value.(type) {
+			case float64:
+				values[clientPolicyAttributeName(setting.key)] = int(v)
+			case string:
+				values[clientPolicyAttributeName(setting.key)], _ = strconv.Atoi(v)
+			default:
+				values[clientPolicyAttributeName(setting.key)] = 0
+			}
+		case schema.TypeString:
+			if v, ok := value.(string); ok {
+				values[clientPolicyAttributeName(setting.key)] = v
+			} else {
+				values[clientPolicyAttributeName(setting.key)] = ""
+			}
+		case schema.TypeList:
+			var list []interface{}
+			switch v := value.(type) {
+			case []interface{}:
+				list = v
+			case string:
+				json.Unmarshal([]byte(v), &list)
+			}
+
+			var stringList []interface{}
+			for _, element := range list {
+				stringList = append(stringList, fmt.Sprintf("%v", element))
+			}
+
+			values[clientPolicyAttributeName(setting.key)] = stringList
+		}
+	}
+
+	executorData[clientPolicyAttributeName(executor)] = []interface{}{values}
+}
+
+// validateClientPolicyConfiguration checks that configuration values which look like JSON lists or objects can be
+// decoded, since they would otherwise be sent to Keycloak as plain strings.
+func validateClientPolicyConfiguration(kind, providerId string, configuration map[string]interface{}) error {
+	var keys []string
+	for key := range configuration {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, ok := configuration[key].(string)
+		if !ok || !(strings.HasPrefix(value, "[") || strings.HasPrefix(value, "{")) {
+			continue
+		}
+
+		var decodedValue interface{}
+		if err := json.Unmarshal([]byte(value), &decodedValue); err != nil {
+			return fmt.Errorf("configuration value %s of %s %s is not valid JSON: %s", key, kind, providerId, err)
+		}
+	}
+
+	return nil
+}
+
+func customizeDiffValidateClientPolicyExecutors(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChange("executor") || !d.NewValueKnown("executor") {
+		return nil
+	}
+
+	for _, v := range d.Get("executor").([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		executorData := v.(map[string]interface{})
+		executor := executorData["executor"].(string)
+		configuration := executorData["configuration"].(map[string]interface{})
+
+		for blockExecutor := range clientPolicyExecutorSettings {
+			block := executorData[clientPolicyAttributeName(blockExecutor)].([]interface{})
+			if len(block) != 0 && blockExecutor != executor {
+				return fmt.Errorf("the %s block can only be used with the %s executor", clientPolicyAttributeName(blockExecutor), blockExecutor)
+			}
+		}
+
+		if _, ok := clientPolicyExecutorSettings[executor]; ok && len(configuration) != 0 {
+			return fmt.Errorf("the configuration of the %s executor must be set with the %s block", executor, clientPolicyAttributeName(executor))
+		}
+
+		if err := validateClientPolicyConfiguration("executor", executor, configuration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func customizeDiffValidateClientPolicyConditions(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChange("condition") || !d.NewValueKnown("condition") {
+		return nil
+	}
+
+	for _, v := range d.Get("condition").([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		condition := v.(map[string]interface{})
+
+		if err := validateClientPolicyConfiguration("condition", condition["condition"].(string), condition["configuration"].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
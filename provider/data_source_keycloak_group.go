@@ -31,6 +31,7 @@ func dataSourceKeycloakGroup() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"multivalued_attributes": dataSourceMultivaluedAttributesSchema(),
 		},
 	}
 }
@@ -47,6 +48,7 @@ func dataSourceKeycloakGroupRead(ctx context.Context, data *schema.ResourceData,
 	}
 
 	mapFromGroupToData(data, group)
+	setDataSourceAttributesData(data, group.Attributes)
 
 	return nil
 }
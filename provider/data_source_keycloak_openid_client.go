@@ -234,6 +234,22 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"pushed_authorization_requests_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dpop_bound_access_tokens": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ciba_grant_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ciba_backchannel_auth_request_signing_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -48,6 +48,11 @@ func dataSourceKeycloakOpenidClientServiceAccountUser() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"multivalued_attributes": dataSourceMultivaluedAttributesSchema(),
+			"federation_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"required_actions": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -89,6 +94,7 @@ func dataSourceKeycloakOpenidClientServiceAccountUserRead(ctx context.Context, d
 	}
 
 	mapFromUserToData(data, user)
+	setDataSourceAttributesData(data, user.Attributes)
 
 	return nil
 }
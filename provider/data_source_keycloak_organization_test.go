@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakDataSourceOrganization_basic(t *testing.T) {
+	skipIfFeatureIsNotEnabled(testCtx, t, keycloakClient, keycloak.OrganizationFeature)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOrganization_basic(realmName, organizationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.keycloak_organization.organization", "id", "keycloak_organization.organization", "id"),
+					resource.TestCheckResourceAttrPair("data.keycloak_organization.organization", "alias", "keycloak_organization.organization", "alias"),
+					resource.TestCheckResourceAttr("data.keycloak_organization.organization", "domain.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakOrganization_basic(realm, organization string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+
+	domain {
+		name = "example.com"
+	}
+}
+
+data "keycloak_organization" "organization" {
+	realm_id = keycloak_realm.realm.id
+	name     = keycloak_organization.organization.name
+}
+	`, realm, organization)
+}
@@ -116,6 +116,10 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"organizations_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 
 			// Login Config
 
@@ -442,6 +446,22 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Description: "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
 				Computed:    true,
 			},
+			"password_policy_rule": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 
 			//flow bindings
 			"browser_flow": {
@@ -505,6 +525,30 @@ func dataSourceKeycloakRealm() *schema.Resource {
 					Schema: otpPolicySchema,
 				},
 			},
+			"ciba_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backchannel_token_delivery_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expires_in": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"auth_requested_user_hint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			// WebAuthn
 			"web_authn_policy": {
 				Type:     schema.TypeList,
@@ -542,5 +586,7 @@ func dataSourceKeycloakRealmRead(ctx context.Context, data *schema.ResourceData,
 
 	setRealmData(data, realm)
 
+	data.Set("password_policy_rule", getPasswordPolicyRulesData(realm.PasswordPolicy))
+
 	return nil
 }
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakRealmAdminEventOperationTypes = []string{"CREATE", "UPDATE", "DELETE", "ACTION"}
+
+func dataSourceKeycloakRealmAdminEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRealmAdminEventsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operation_types": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(keycloakRealmAdminEventOperationTypes, false),
+				},
+				Optional: true,
+			},
+			"resource_types": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only return events for these types of resources, such as USER or CLIENT.",
+			},
+			"resource_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events for this resource path. A * matches any path segment, such as users/*.",
+			},
+			"auth_realm_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auth_client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auth_user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auth_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"date_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEventDate,
+				Description:  "Only return events from this date, in the yyyy-MM-dd format.",
+			},
+			"date_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEventDate,
+				Description:  "Only return events up to this date, in the yyyy-MM-dd format.",
+			},
+			"first": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The index of the first event to return, to page through the results.",
+			},
+			"max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of events to return.",
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"operation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"representation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_realm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakRealmAdminEventsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	events, err := keycloakClient.GetRealmAdminEvents(ctx, realmId, &keycloak.RealmAdminEventQuery{
+		OperationTypes: interfaceSliceToStringSlice(data.Get("operation_types").(*schema.Set).List()),
+		ResourceTypes:  interfaceSliceToStringSlice(data.Get("resource_types").(*schema.Set).List()),
+		ResourcePath:   data.Get("resource_path").(string),
+		AuthRealmId:    data.Get("auth_realm_id").(string),
+		AuthClientId:   data.Get("auth_client_id").(string),
+		AuthUserId:     data.Get("auth_user_id").(string),
+		AuthIpAddress:  data.Get("auth_ip_address").(string),
+		DateFrom:       data.Get("date_from").(string),
+		DateTo:         data.Get("date_to").(string),
+		First:          data.Get("first").(int),
+		Max:            data.Get("max").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var eventsData []interface{}
+	for _, event := range events {
+		eventData := map[string]interface{}{
+			"time":           int(event.Time),
+			"operation_type": event.OperationType,
+			"resource_type":  event.ResourceType,
+			"resource_path":  event.ResourcePath,
+			"representation": event.Representation,
+			"error":          event.Error,
+		}
+
+		if event.AuthDetails != nil {
+			eventData["auth_realm_id"] = event.AuthDetails.RealmId
+			eventData["auth_client_id"] = event.AuthDetails.ClientId
+			eventData["auth_user_id"] = event.AuthDetails.UserId
+			eventData["auth_ip_address"] = event.AuthDetails.IpAddress
+		}
+
+		eventsData = append(eventsData, eventData)
+	}
+
+	data.SetId(realmId)
+	data.Set("events", eventsData)
+
+	return nil
+}
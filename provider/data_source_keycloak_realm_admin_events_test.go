@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRealmAdminEvents_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_realm_admin_events.events"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmAdminEvents_resources(realmName, groupName),
+			},
+			{
+				Config: testKeycloakRealmAdminEvents_filtered(realmName, groupName, "CREATE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.operation_type", "CREATE"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.resource_type", "GROUP"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.0.time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.0.auth_user_id"),
+				),
+			},
+			{
+				Config: testKeycloakRealmAdminEvents_filtered(realmName, groupName, "DELETE"),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "events.#", "0"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceRealmAdminEvents_invalidOperationType(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmAdminEvents_filtered(realmName, groupName, "READ"),
+				ExpectError: regexp.MustCompile("expected operation_types.+ to be one of"),
+			},
+		},
+	})
+}
+
+func testKeycloakRealmAdminEvents_resources(realm, group string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_events" "events" {
+	realm_id             = keycloak_realm.realm.id
+	admin_events_enabled = true
+}
+
+resource "keycloak_group" "group" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+
+	depends_on = [keycloak_realm_events.events]
+}
+	`, realm, group)
+}
+
+func testKeycloakRealmAdminEvents_filtered(realm, group, operationType string) string {
+	return fmt.Sprintf(`
+%s
+
+data "keycloak_realm_admin_events" "events" {
+	realm_id        = keycloak_realm.realm.id
+	operation_types = ["%s"]
+	resource_types  = ["GROUP"]
+	resource_path   = "groups/${keycloak_group.group.id}"
+}
+	`, testKeycloakRealmAdminEvents_resources(realm, group), operationType)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRealmLocalization() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRealmLocalizationRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"theme_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "login",
+				ValidateFunc: validation.StringInSlice([]string{"login", "account", "admin", "email"}, false),
+				Description:  "The type of the theme whose message bundle is merged with the overrides of the realm.",
+			},
+			"theme": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The theme whose message bundle is merged with the overrides of the realm. Defaults to the theme of the realm for the theme type.",
+			},
+			"use_realm_default_locale_fallback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, overrides of the realm's default locale are returned for keys that are not overridden for this locale.",
+			},
+			"texts": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"overrides": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getRealmThemeForType(realm *keycloak.Realm, themeType string) string {
+	switch themeType {
+	case "account":
+		return realm.AccountTheme
+	case "admin":
+		return realm.AdminTheme
+	case "email":
+		return realm.EmailTheme
+	default:
+		return realm.LoginTheme
+	}
+}
+
+func dataSourceKeycloakRealmLocalizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+	themeType := data.Get("theme_type").(string)
+
+	if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_22); err != nil {
+		return diag.FromErr(err)
+	} else if !ok {
+		return diag.Errorf("reading the effective localization texts of a realm requires Keycloak 22 or later")
+	}
+
+	theme := data.Get("theme").(string)
+	if theme == "" {
+		realm, err := keycloakClient.GetRealm(ctx, realmId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		theme = getRealmThemeForType(realm, themeType)
+	}
+
+	// Keycloak uses its default theme when the realm does not set one
+	if theme == "" {
+		theme = "keycloak"
+	}
+
+	texts, err := keycloakClient.GetRealmEffectiveLocalizationTexts(ctx, realmId, theme, themeType, locale)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	overrides, err := keycloakClient.GetRealmLocalizationTexts(ctx, realmId, locale, data.Get("use_realm_default_locale_fallback").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, locale))
+	data.Set("texts", texts)
+	data.Set("overrides", overrides)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakDataSourceRealmLocalization_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_22); !ok {
+		t.Skip()
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_realm_localization.localization"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakRealmLocalization_basic(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "texts.loginTitle", "Willkommen"),
+					// texts that are not overridden are read from the message bundle of the theme
+					resource.TestCheckResourceAttrSet(dataSourceName, "texts.doLogIn"),
+					resource.TestCheckResourceAttr(dataSourceName, "overrides.loginTitle", "Willkommen"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "overrides.doLogIn"),
+					// falls back to the overrides of the default locale
+					resource.TestCheckResourceAttr(dataSourceName, "overrides.usernameOrEmail", "Login"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakRealmLocalization_basic(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	internationalization {
+		supported_locales = ["en", "de"]
+		default_locale    = "en"
+	}
+}
+
+resource "keycloak_realm_localization" "en" {
+	realm_id = keycloak_realm.realm.id
+	locale   = "en"
+
+	texts = {
+		loginTitle      = "Welcome"
+		usernameOrEmail = "Login"
+	}
+}
+
+resource "keycloak_realm_localization" "de" {
+	realm_id = keycloak_realm.realm.id
+	locale   = "de"
+
+	texts = {
+		loginTitle = "Willkommen"
+	}
+}
+
+data "keycloak_realm_localization" "localization" {
+	realm_id = keycloak_realm.realm.id
+	locale   = "de"
+
+	depends_on = [
+		keycloak_realm_localization.en,
+		keycloak_realm_localization.de,
+	]
+}
+	`, realm)
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRealmLoginEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRealmLoginEventsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"types": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only return events of these types, such as LOGIN or LOGIN_ERROR.",
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"date_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEventDate,
+				Description:  "Only return events from this date, in the yyyy-MM-dd format.",
+			},
+			"date_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEventDate,
+				Description:  "Only return events up to this date, in the yyyy-MM-dd format.",
+			},
+			"first": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The index of the first event to return, to page through the results.",
+			},
+			"max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of events to return.",
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"session_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"details": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateEventDate(i interface{}, k string) (s []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := time.Parse("2006-01-02", v); err != nil {
+		errs = append(errs, fmt.Errorf("expected %s to be a date in the yyyy-MM-dd format, got %s", k, v))
+	}
+
+	return
+}
+
+func dataSourceKeycloakRealmLoginEventsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	events, err := keycloakClient.GetRealmEvents(ctx, realmId, &keycloak.RealmEventQuery{
+		Types:     interfaceSliceToStringSlice(data.Get("types").(*schema.Set).List()),
+		ClientId:  data.Get("client_id").(string),
+		UserId:    data.Get("user_id").(string),
+		IpAddress: data.Get("ip_address").(string),
+		DateFrom:  data.Get("date_from").(string),
+		DateTo:    data.Get("date_to").(string),
+		First:     data.Get("first").(int),
+		Max:       data.Get("max").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var eventsData []interface{}
+	for _, event := range events {
+		eventsData = append(eventsData, map[string]interface{}{
+			"time":       int(event.Time),
+			"type":       event.Type,
+			"client_id":  event.ClientId,
+			"user_id":    event.UserId,
+			"session_id": event.SessionId,
+			"ip_address": event.IpAddress,
+			"error":      event.Error,
+			"details":    event.Details,
+		})
+	}
+
+	data.SetId(realmId)
+	data.Set("events", eventsData)
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRealmLoginEvents_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_realm_login_events.events"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLoginEvents_resources(realmName, username),
+			},
+			{
+				PreConfig: func() {
+					if err := testAccKeycloakPasswordGrant(realmName, "tf-acc-client", username, "password", http.StatusOK); err != nil {
+						t.Fatal(err)
+					}
+
+					if err := testAccKeycloakPasswordGrant(realmName, "tf-acc-client", username, "wrong-password", http.StatusUnauthorized); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmLoginEvents_filtered(realmName, username, "LOGIN", 0, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.type", "LOGIN"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.client_id", "tf-acc-client"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "events.0.user_id", "keycloak_user.user", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.0.time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.0.session_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.details.username", username),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.details.grant_type", "password"),
+				),
+			},
+			{
+				Config: testKeycloakRealmLoginEvents_filtered(realmName, username, "LOGIN_ERROR", 0, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.type", "LOGIN_ERROR"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.error", "invalid_user_credentials"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "events.0.user_id", "keycloak_user.user", "id"),
+				),
+			},
+			// both events match, but only one is returned per page
+			{
+				Config: testKeycloakRealmLoginEvents_filtered(realmName, username, "", 0, 1),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "events.#", "1"),
+			},
+			{
+				Config: testKeycloakRealmLoginEvents_filtered(realmName, username, "", 1, 1),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "events.#", "1"),
+			},
+			{
+				Config: testKeycloakRealmLoginEvents_filtered(realmName, username, "", 2, 1),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "events.#", "0"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceRealmLoginEvents_invalidDate(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmLoginEvents_invalidDate(realmName, "01/01/2020"),
+				ExpectError: regexp.MustCompile("expected date_from to be a date in the yyyy-MM-dd format"),
+			},
+		},
+	})
+}
+
+// testAccKeycloakPasswordGrant requests a token for the user with the password grant, which records a LOGIN or a
+// LOGIN_ERROR event in the realm.
+func testAccKeycloakPasswordGrant(realm, clientId, username, password string, expectedStatusCode int) error {
+	resourceUrl := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", os.Getenv("KEYCLOAK_URL"), realm)
+
+	form := url.Values{}
+	form.Add("username", username)
+	form.Add("password", password)
+	form.Add("client_id", clientId)
+	form.Add("grant_type", "password")
+
+	response, err := http.Post(resourceUrl, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != expectedStatusCode {
+		return fmt.Errorf("expected password grant for user %s to respond with %d, but got %d", username, expectedStatusCode, response.StatusCode)
+	}
+
+	return nil
+}
+
+func testKeycloakRealmLoginEvents_resources(realm, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_events" "events" {
+	realm_id       = keycloak_realm.realm.id
+	events_enabled = true
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = keycloak_realm.realm.id
+	client_id                    = "tf-acc-client"
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password {
+		value     = "password"
+		temporary = false
+	}
+}
+	`, realm, username)
+}
+
+func testKeycloakRealmLoginEvents_filtered(realm, username, eventType string, first, max int) string {
+	types := `["LOGIN", "LOGIN_ERROR"]`
+	if eventType != "" {
+		types = fmt.Sprintf(`["%s"]`, eventType)
+	}
+
+	return fmt.Sprintf(`
+%s
+
+data "keycloak_realm_login_events" "events" {
+	realm_id  = keycloak_realm_events.events.realm_id
+	types     = %s
+	client_id = keycloak_openid_client.client.client_id
+	user_id   = keycloak_user.user.id
+	date_from = "2020-01-01"
+	first     = %d
+	max       = %d
+}
+	`, testKeycloakRealmLoginEvents_resources(realm, username), types, first, max)
+}
+
+func testKeycloakRealmLoginEvents_invalidDate(realm, dateFrom string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_realm_login_events" "events" {
+	realm_id  = keycloak_realm.realm.id
+	date_from = "%s"
+}
+	`, realm, dateFrom)
+}
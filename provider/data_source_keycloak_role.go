@@ -37,6 +37,7 @@ func dataSourceKeycloakRole() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"multivalued_attributes": dataSourceMultivaluedAttributesSchema(),
 		},
 	}
 }
@@ -54,6 +55,7 @@ func dataSourceKeycloakRoleRead(ctx context.Context, data *schema.ResourceData,
 	}
 
 	mapFromRoleToData(data, role)
+	setDataSourceAttributesData(data, role.Attributes)
 
 	return nil
 }
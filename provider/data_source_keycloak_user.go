@@ -40,6 +40,11 @@ func dataSourceKeycloakUser() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"multivalued_attributes": dataSourceMultivaluedAttributesSchema(),
+			"federation_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"required_actions": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -73,6 +78,7 @@ func dataSourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData,
 	}
 
 	mapFromUserToData(data, user)
+	setDataSourceAttributesData(data, user.Attributes)
 
 	return nil
 }
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUsersRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A string contained in the username, first name, last name or email of the users.",
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exact": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, username, email, first_name and last_name must match exactly instead of partially.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"email_verified": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"idp_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return users linked to the identity provider with this alias.",
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only return users that have all of these attribute values.",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of users to return. When 0, all matching users are returned.",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email_verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"multivalued_attributes": dataSourceMultivaluedAttributesSchema(),
+						"required_actions": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"federation_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUsersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	rawConfig := data.GetRawConfig()
+
+	userQuery := &keycloak.UserQuery{
+		Search:     data.Get("search").(string),
+		Username:   data.Get("username").(string),
+		Email:      data.Get("email").(string),
+		FirstName:  data.Get("first_name").(string),
+		LastName:   data.Get("last_name").(string),
+		Exact:      data.Get("exact").(bool),
+		IdpAlias:   data.Get("idp_alias").(string),
+		Attributes: make(map[string]string),
+		Max:        data.Get("max_results").(int),
+	}
+
+	if attributeIsConfigured(rawConfig, "enabled") {
+		enabled := data.Get("enabled").(bool)
+		userQuery.Enabled = &enabled
+	}
+
+	if attributeIsConfigured(rawConfig, "email_verified") {
+		emailVerified := data.Get("email_verified").(bool)
+		userQuery.EmailVerified = &emailVerified
+	}
+
+	for key, value := range data.Get("attributes").(map[string]interface{}) {
+		userQuery.Attributes[key] = value.(string)
+	}
+
+	if len(userQuery.Attributes) != 0 {
+		if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_15); err != nil {
+			return diag.FromErr(err)
+		} else if !ok {
+			return diag.Errorf("searching users by attributes requires Keycloak 15 or later")
+		}
+	}
+
+	users, err := keycloakClient.SearchUsers(ctx, realmId, userQuery)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var usersData []interface{}
+	for _, user := range users {
+		attributes := map[string]string{}
+		for key, values := range user.Attributes {
+			attributes[key] = strings.Join(values, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		usersData = append(usersData, map[string]interface{}{
+			"id":                     user.Id,
+			"username":               user.Username,
+			"email":                  user.Email,
+			"email_verified":         user.EmailVerified,
+			"first_name":             user.FirstName,
+			"last_name":              user.LastName,
+			"enabled":                user.Enabled,
+			"attributes":             attributes,
+			"multivalued_attributes": flattenMultivaluedAttributes(user.Attributes),
+			"required_actions":       user.RequiredActions,
+			"federation_link":        user.FederationLink,
+		})
+	}
+
+	data.SetId(realmId)
+	data.Set("users", usersData)
+
+	return nil
+}
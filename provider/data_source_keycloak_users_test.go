@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakDataSourceUsers_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_15); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUsers_basic(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_users.team_a", "users.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.keycloak_users.team_a", "users.*.id", "keycloak_user.user_1", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.keycloak_users.team_a", "users.*.id", "keycloak_user.user_2", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_users.team_a", "users.*", map[string]string{
+						"username":        "user-1",
+						"attributes.team": "a",
+					}),
+					resource.TestCheckResourceAttr("data.keycloak_users.disabled", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.keycloak_users.disabled", "users.0.id", "keycloak_user.user_3", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_users.limited", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_users.exact", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_users.exact", "users.0.username", "user-1"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUsers_basic(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user_1" {
+	realm_id = keycloak_realm.realm.id
+	username = "user-1"
+
+	attributes = {
+		team = "a"
+	}
+}
+
+resource "keycloak_user" "user_2" {
+	realm_id = keycloak_realm.realm.id
+	username = "user-2"
+
+	attributes = {
+		team = "a"
+	}
+}
+
+resource "keycloak_user" "user_3" {
+	realm_id = keycloak_realm.realm.id
+	username = "user-3"
+	enabled  = false
+
+	attributes = {
+		team = "b"
+	}
+}
+
+data "keycloak_users" "team_a" {
+	realm_id    = keycloak_realm.realm.id
+	attributes  = {
+		team = "a"
+	}
+	max_results = 0
+
+	depends_on = [keycloak_user.user_1, keycloak_user.user_2, keycloak_user.user_3]
+}
+
+data "keycloak_users" "disabled" {
+	realm_id = keycloak_realm.realm.id
+	enabled  = false
+
+	depends_on = [keycloak_user.user_1, keycloak_user.user_2, keycloak_user.user_3]
+}
+
+data "keycloak_users" "limited" {
+	realm_id    = keycloak_realm.realm.id
+	search      = "user-"
+	max_results = 2
+
+	depends_on = [keycloak_user.user_1, keycloak_user.user_2, keycloak_user.user_3]
+}
+
+data "keycloak_users" "exact" {
+	realm_id = keycloak_realm.realm.id
+	username = "user-1"
+	exact    = true
+
+	depends_on = [keycloak_user.user_1, keycloak_user.user_2, keycloak_user.user_3]
+}
+	`, realm)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// Deletion protection prevents resources that are expensive to recreate, such as realms and clients, from being deleted
+// by accident, for example when a resource is renamed or removed from configuration. It is not sent to Keycloak and
+// only exists in state. When it is not set on a resource, the `deletion_protection` default from the provider is used.
+
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "When true, the resource cannot be deleted by Terraform until this is set to false and applied. Defaults to the `deletion_protection` value of the provider.",
+	}
+}
+
+func customizeDiffDeletionProtection(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	if !rawConfig.GetAttr("deletion_protection").IsNull() {
+		return nil
+	}
+
+	keycloakClient, ok := meta.(*keycloak.KeycloakClient)
+	if !ok || keycloakClient == nil {
+		return nil
+	}
+
+	if d.Id() != "" && d.Get("deletion_protection").(bool) == keycloakClient.DeletionProtection() {
+		return nil
+	}
+
+	return d.SetNew("deletion_protection", keycloakClient.DeletionProtection())
+}
+
+func checkDeletionProtection(data *schema.ResourceData, description string) diag.Diagnostics {
+	if !data.Get("deletion_protection").(bool) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s is protected from deletion", description),
+			Detail:   fmt.Sprintf("%s with id %s cannot be deleted because deletion_protection is enabled. Set deletion_protection = false and apply the change before deleting it.", description, data.Id()),
+		},
+	}
+}
+
+// Imported resources start without deletion_protection in state, so it is set to the provider default here to avoid a
+// diff on the first plan after an import.
+func importWithDeletionProtection(importer schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		results, err := importer(ctx, data, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		keycloakClient, ok := meta.(*keycloak.KeycloakClient)
+		if !ok || keycloakClient == nil {
+			return results, nil
+		}
+
+		for _, result := range results {
+			if err := result.Set("deletion_protection", keycloakClient.DeletionProtection()); err != nil {
+				return nil, err
+			}
+		}
+
+		return results, nil
+	}
+}
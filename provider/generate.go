@@ -0,0 +1,925 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Objects that Keycloak creates for every realm. They are left out of the generated configuration, and references to
+// them are written as plain strings.
+var (
+	generateBuiltInClients = []string{
+		"account",
+		"account-console",
+		"admin-cli",
+		"broker",
+		"realm-management",
+		"security-admin-console",
+	}
+	generateBuiltInClientScopes = []string{
+		"acr",
+		"address",
+		"basic",
+		"email",
+		"microprofile-jwt",
+		"offline_access",
+		"phone",
+		"profile",
+		"role_list",
+		"roles",
+		"saml_organization",
+		"web-origins",
+	}
+	generateBuiltInRealmRoles = []string{
+		"offline_access",
+		"uma_authorization",
+	}
+)
+
+// Attributes that are managed by another generated resource, and are therefore left out of the configuration
+var generateOmittedAttributes = map[string][]string{
+	// flow bindings are generated as a keycloak_authentication_bindings resource, which avoids a dependency cycle
+	// between the realm and its flows
+	"keycloak_realm": {
+		"browser_flow",
+		"registration_flow",
+		"direct_grant_flow",
+		"reset_credentials_flow",
+		"client_authentication_flow",
+		"docker_authentication_flow",
+	},
+}
+
+// Attributes that refer to other objects by name rather than by ID. The value is the kind of name being referenced.
+// Keys are either an attribute name or a resource type and attribute name separated by a dot.
+var generateNameReferenceKinds = map[string]string{
+	"realm_id":                      "realm",
+	"realm":                         "realm",
+	"parent_flow_alias":             "flow",
+	"first_broker_login_flow_alias": "flow",
+	"post_broker_login_flow_alias":  "flow",
+	"identity_provider_alias":       "identity_provider",
+
+	"keycloak_authentication_bindings.browser_flow":               "flow",
+	"keycloak_authentication_bindings.registration_flow":          "flow",
+	"keycloak_authentication_bindings.direct_grant_flow":          "flow",
+	"keycloak_authentication_bindings.reset_credentials_flow":     "flow",
+	"keycloak_authentication_bindings.client_authentication_flow": "flow",
+	"keycloak_authentication_bindings.docker_authentication_flow": "flow",
+
+	"keycloak_openid_client_default_scopes.default_scopes":   "openid_client_scope",
+	"keycloak_openid_client_optional_scopes.optional_scopes": "openid_client_scope",
+	"keycloak_saml_client_default_scopes.default_scopes":     "saml_client_scope",
+}
+
+var generateIdentityProviderResourceTypes = map[string]string{
+	"oidc":          "keycloak_oidc_identity_provider",
+	"keycloak-oidc": "keycloak_oidc_identity_provider",
+	"saml":          "keycloak_saml_identity_provider",
+	"google":        "keycloak_oidc_google_identity_provider",
+}
+
+var generateLdapMapperResourceTypes = map[string]string{
+	"full-name-ldap-mapper":                "keycloak_ldap_full_name_mapper",
+	"group-ldap-mapper":                    "keycloak_ldap_group_mapper",
+	"hardcoded-ldap-group-mapper":          "keycloak_ldap_hardcoded_group_mapper",
+	"hardcoded-ldap-role-mapper":           "keycloak_ldap_hardcoded_role_mapper",
+	"hardcoded-ldap-attribute-mapper":      "keycloak_ldap_hardcoded_attribute_mapper",
+	"msad-lds-user-account-control-mapper": "keycloak_ldap_msad_lds_user_account_control_mapper",
+	"msad-user-account-control-mapper":     "keycloak_ldap_msad_user_account_control_mapper",
+	"user-attribute-ldap-mapper":           "keycloak_ldap_user_attribute_mapper",
+	"role-ldap-mapper":                     "keycloak_ldap_role_mapper",
+}
+
+var generateKeystoreResourceTypes = map[string]string{
+	"aes-generated":     "keycloak_realm_keystore_aes_generated",
+	"ecdh-generated":    "keycloak_realm_keystore_ecdh_generated",
+	"ecdsa-generated":   "keycloak_realm_keystore_ecdsa_generated",
+	"eddsa-generated":   "keycloak_realm_keystore_eddsa_generated",
+	"hmac-generated":    "keycloak_realm_keystore_hmac_generated",
+	"java-keystore":     "keycloak_realm_keystore_java_keystore",
+	"rsa":               "keycloak_realm_keystore_rsa",
+	"rsa-enc-generated": "keycloak_realm_keystore_rsa_enc_generated",
+	"rsa-generated":     "keycloak_realm_keystore_rsa_generated",
+}
+
+var generateInvalidNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type generatedResource struct {
+	resourceType string
+	name         string
+	importId     string
+	data         *schema.ResourceData
+	dependsOn    []string
+}
+
+func (r *generatedResource) address() string {
+	return fmt.Sprintf("%s.%s", r.resourceType, r.name)
+}
+
+type realmConfigurationGenerator struct {
+	keycloakClient *keycloak.KeycloakClient
+	resources      map[string]*schema.Resource
+
+	generated []*generatedResource
+	skipped   []string
+	names     map[string]bool
+
+	// object ID => expression referencing the generated resource
+	idReferences map[string]string
+	// kind => name => expression referencing the generated resource
+	nameReferences map[string]map[string]string
+
+	// variables for the sensitive attributes that are not exported, but have to be set
+	variables []*generatedVariable
+}
+
+type generatedVariable struct {
+	name         string
+	description  string
+	variableType string
+}
+
+// GenerateRealmConfiguration reads the objects of a realm that can be managed by this provider and returns Terraform
+// configuration for them. Every resource is accompanied by an import block, so that the existing objects are adopted
+// into state rather than recreated. Resources reference each other instead of using IDs wherever possible.
+func GenerateRealmConfiguration(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmName string) ([]byte, error) {
+	generator := &realmConfigurationGenerator{
+		keycloakClient: keycloakClient,
+		resources:      KeycloakProvider(keycloakClient).ResourcesMap,
+		names:          make(map[string]bool),
+		idReferences:   make(map[string]string),
+		nameReferences: make(map[string]map[string]string),
+	}
+
+	err := generator.walkRealm(ctx, realmName)
+	if err != nil {
+		return nil, err
+	}
+
+	return generator.render(realmName), nil
+}
+
+func (g *realmConfigurationGenerator) walkRealm(ctx context.Context, realmName string) error {
+	realm, err := g.keycloakClient.GetRealm(ctx, realmName)
+	if err != nil {
+		return fmt.Errorf("error getting realm %s: %s", realmName, err)
+	}
+
+	realmResource, err := g.add(ctx, "keycloak_realm", realm.Realm, realm.Realm)
+	if err != nil {
+		return err
+	}
+	g.referenceName("realm", realm.Realm, realmResource, "id")
+
+	walks := []func(context.Context, *keycloak.Realm) error{
+		g.walkClientScopes,
+		g.walkClients,
+		g.walkRealmRoles,
+		g.walkGroups,
+		g.walkAuthenticationFlows,
+		g.walkIdentityProviders,
+		g.walkUserFederation,
+		g.walkKeystores,
+	}
+
+	for _, walk := range walks {
+		err = walk(ctx, realm)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkClientScopes(ctx context.Context, realm *keycloak.Realm) error {
+	openidClientScopes, err := g.keycloakClient.ListOpenidClientScopesWithFilter(ctx, realm.Realm, func(*keycloak.OpenidClientScope) bool { return true })
+	if err != nil {
+		return err
+	}
+
+	for _, clientScope := range openidClientScopes {
+		if stringSliceContains(generateBuiltInClientScopes, clientScope.Name) {
+			continue
+		}
+
+		r, err := g.add(ctx, "keycloak_openid_client_scope", clientScope.Name, fmt.Sprintf("%s/%s", realm.Realm, clientScope.Id))
+		if err != nil {
+			return err
+		}
+		g.referenceId(clientScope.Id, r)
+		g.referenceName("openid_client_scope", clientScope.Name, r, "name")
+
+		err = g.walkClientScopeProtocolMappers(ctx, realm, clientScope.Id, clientScope.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	samlClientScopes, err := g.keycloakClient.ListSamlClientScopesWithFilter(ctx, realm.Realm, func(*keycloak.SamlClientScope) bool { return true })
+	if err != nil {
+		return err
+	}
+
+	for _, clientScope := range samlClientScopes {
+		if stringSliceContains(generateBuiltInClientScopes, clientScope.Name) {
+			continue
+		}
+
+		r, err := g.add(ctx, "keycloak_saml_client_scope", clientScope.Name, fmt.Sprintf("%s/%s", realm.Realm, clientScope.Id))
+		if err != nil {
+			return err
+		}
+		g.referenceId(clientScope.Id, r)
+		g.referenceName("saml_client_scope", clientScope.Name, r, "name")
+
+		err = g.walkClientScopeProtocolMappers(ctx, realm, clientScope.Id, clientScope.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkClientScopeProtocolMappers(ctx context.Context, realm *keycloak.Realm, clientScopeId, clientScopeName string) error {
+	protocolMappers, err := g.keycloakClient.GetClientScopeGenericProtocolMappers(ctx, realm.Realm, clientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		_, err = g.add(ctx, "keycloak_generic_protocol_mapper", clientScopeName+"_"+protocolMapper.Name, fmt.Sprintf("%s/client-scope/%s/%s", realm.Realm, clientScopeId, protocolMapper.Id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkClients(ctx context.Context, realm *keycloak.Realm) error {
+	clients, err := g.keycloakClient.GetGenericClients(ctx, realm.Realm)
+	if err != nil {
+		return err
+	}
+
+	for _, client := range clients {
+		// the master realm contains a management client for every other realm
+		if stringSliceContains(generateBuiltInClients, client.ClientId) || (realm.Realm == "master" && strings.HasSuffix(client.ClientId, "-realm")) {
+			continue
+		}
+
+		clientImportId := fmt.Sprintf("%s/%s", realm.Realm, client.Id)
+
+		var clientResourceType string
+		var scopeResourceTypes []string
+
+		switch client.Protocol {
+		case "openid-connect":
+			clientResourceType = "keycloak_openid_client"
+			scopeResourceTypes = []string{"keycloak_openid_client_default_scopes", "keycloak_openid_client_optional_scopes"}
+		case "saml":
+			clientResourceType = "keycloak_saml_client"
+			scopeResourceTypes = []string{"keycloak_saml_client_default_scopes"}
+		default:
+			g.skip("client", client.ClientId, fmt.Sprintf("protocol %s is not supported", client.Protocol))
+			continue
+		}
+
+		r, err := g.add(ctx, clientResourceType, client.ClientId, clientImportId)
+		if err != nil {
+			return err
+		}
+		g.referenceId(client.Id, r)
+
+		for _, scopeResourceType := range scopeResourceTypes {
+			_, err = g.add(ctx, scopeResourceType, client.ClientId, clientImportId)
+			if err != nil {
+				return err
+			}
+		}
+
+		clientWithProtocolMappers, err := g.keycloakClient.GetGenericProtocolMappers(ctx, realm.Realm, client.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, protocolMapper := range clientWithProtocolMappers.ProtocolMappers {
+			_, err = g.add(ctx, "keycloak_generic_protocol_mapper", client.ClientId+"_"+protocolMapper.Name, fmt.Sprintf("%s/client/%s/%s", realm.Realm, client.Id, protocolMapper.Id))
+			if err != nil {
+				return err
+			}
+		}
+
+		roles, err := g.keycloakClient.GetClientRoles(ctx, realm.Realm, []*keycloak.OpenidClient{{Id: client.Id}})
+		if err != nil {
+			return err
+		}
+
+		for _, role := range roles {
+			err = g.addRole(ctx, realm, role, client.ClientId+"_"+role.Name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkRealmRoles(ctx context.Context, realm *keycloak.Realm) error {
+	roles, err := g.keycloakClient.GetRealmRoles(ctx, realm.Realm)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if stringSliceContains(generateBuiltInRealmRoles, role.Name) || role.Name == fmt.Sprintf("default-roles-%s", strings.ToLower(realm.Realm)) {
+			continue
+		}
+
+		err = g.addRole(ctx, realm, role, role.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) addRole(ctx context.Context, realm *keycloak.Realm, role *keycloak.Role, name string) error {
+	r, err := g.add(ctx, "keycloak_role", name, fmt.Sprintf("%s/%s", realm.Realm, role.Id))
+	if err != nil {
+		return err
+	}
+	g.referenceId(role.Id, r)
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkGroups(ctx context.Context, realm *keycloak.Realm) error {
+	groups, err := g.keycloakClient.GetGroups(ctx, realm.Realm)
+	if err != nil {
+		return err
+	}
+
+	return g.addGroups(ctx, realm, groups)
+}
+
+func (g *realmConfigurationGenerator) addGroups(ctx context.Context, realm *keycloak.Realm, groups []*keycloak.Group) error {
+	for _, group := range groups {
+		name := strings.Join(strings.Split(strings.TrimPrefix(group.Path, "/"), "/"), "_")
+		groupImportId := fmt.Sprintf("%s/%s", realm.Realm, group.Id)
+
+		r, err := g.add(ctx, "keycloak_group", name, groupImportId)
+		if err != nil {
+			return err
+		}
+		g.referenceId(group.Id, r)
+
+		roleMappings, err := g.keycloakClient.GetGroupRoleMappings(ctx, realm.Realm, group.Id)
+		if err != nil {
+			return err
+		}
+
+		if len(roleMappings.RealmMappings) != 0 || len(roleMappings.ClientMappings) != 0 {
+			_, err = g.add(ctx, "keycloak_group_roles", name, groupImportId)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = g.addGroups(ctx, realm, group.SubGroups)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkAuthenticationFlows(ctx context.Context, realm *keycloak.Realm) error {
+	flows, err := g.keycloakClient.ListAuthenticationFlows(ctx, realm.Realm)
+	if err != nil {
+		return err
+	}
+
+	for _, flow := range flows {
+		if flow.BuiltIn || !flow.TopLevel {
+			continue
+		}
+
+		r, err := g.add(ctx, "keycloak_authentication_flow", flow.Alias, fmt.Sprintf("%s/%s", realm.Realm, flow.Id))
+		if err != nil {
+			return err
+		}
+		g.referenceId(flow.Id, r)
+		g.referenceName("flow", flow.Alias, r, "alias")
+
+		err = g.walkAuthenticationExecutions(ctx, realm, flow.Alias)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = g.add(ctx, "keycloak_authentication_bindings", realm.Realm, realm.Realm)
+
+	return err
+}
+
+// Executions are returned as a flat list in display order, where the level of an execution is its depth within the
+// top level flow. Keycloak orders executions by the time they were created, so every execution depends on its previous
+// sibling in order to preserve that order when the configuration is applied to another realm.
+func (g *realmConfigurationGenerator) walkAuthenticationExecutions(ctx context.Context, realm *keycloak.Realm, flowAlias string) error {
+	executions, err := g.keycloakClient.ListAuthenticationExecutions(ctx, realm.Realm, flowAlias)
+	if err != nil {
+		return err
+	}
+
+	parentAliases := map[int]string{0: flowAlias}
+	previousSiblings := make(map[int]*generatedResource)
+
+	for _, execution := range executions {
+		parentFlowAlias := parentAliases[execution.Level]
+
+		var r *generatedResource
+		if execution.AuthenticationFlow {
+			r, err = g.add(ctx, "keycloak_authentication_subflow", parentFlowAlias+"_"+execution.DisplayName, fmt.Sprintf("%s/%s/%s", realm.Realm, parentFlowAlias, execution.FlowId))
+			if err != nil {
+				return err
+			}
+
+			subFlowAlias := r.data.Get("alias").(string)
+			g.referenceId(execution.FlowId, r)
+			g.referenceName("flow", subFlowAlias, r, "alias")
+
+			parentAliases[execution.Level+1] = subFlowAlias
+			delete(previousSiblings, execution.Level+1)
+		} else {
+			r, err = g.add(ctx, "keycloak_authentication_execution", parentFlowAlias+"_"+execution.ProviderId, fmt.Sprintf("%s/%s/%s", realm.Realm, parentFlowAlias, execution.Id))
+			if err != nil {
+				return err
+			}
+			g.referenceId(execution.Id, r)
+
+			if execution.AuthenticationConfig != "" {
+				_, err = g.add(ctx, "keycloak_authentication_execution_config", parentFlowAlias+"_"+execution.ProviderId, fmt.Sprintf("%s/%s/%s", realm.Realm, execution.Id, execution.AuthenticationConfig))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if previousSibling, ok := previousSiblings[execution.Level]; ok {
+			r.dependsOn = append(r.dependsOn, previousSibling.address())
+		}
+		previousSiblings[execution.Level] = r
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkIdentityProviders(ctx context.Context, realm *keycloak.Realm) error {
+	identityProviders, err := g.keycloakClient.GetIdentityProviders(ctx, realm.Realm)
+	if err != nil {
+		return err
+	}
+
+	for _, identityProvider := range identityProviders {
+		resourceType, ok := generateIdentityProviderResourceTypes[identityProvider.ProviderId]
+		if !ok {
+			g.skip("identity provider", identityProvider.Alias, fmt.Sprintf("provider %s is not supported", identityProvider.ProviderId))
+			continue
+		}
+
+		r, err := g.add(ctx, resourceType, identityProvider.Alias, fmt.Sprintf("%s/%s", realm.Realm, identityProvider.Alias))
+		if err != nil {
+			return err
+		}
+		g.referenceName("identity_provider", identityProvider.Alias, r, "alias")
+
+		mappers, err := g.keycloakClient.GetIdentityProviderMappers(ctx, realm.Realm, identityProvider.Alias)
+		if err != nil {
+			return err
+		}
+
+		for _, mapper := range mappers {
+			_, err = g.add(ctx, "keycloak_custom_identity_provider_mapper", identityProvider.Alias+"_"+mapper.Name, fmt.Sprintf("%s/%s/%s", realm.Realm, identityProvider.Alias, mapper.Id))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkUserFederation(ctx context.Context, realm *keycloak.Realm) error {
+	components, err := g.keycloakClient.ListComponents(ctx, realm.Realm, realm.Id, "org.keycloak.storage.UserStorageProvider")
+	if err != nil {
+		return err
+	}
+
+	for _, component := range components {
+		importId := fmt.Sprintf("%s/%s", realm.Realm, component.Id)
+
+		if component.ProviderId != "ldap" {
+			r, err := g.add(ctx, "keycloak_custom_user_federation", component.Name, importId)
+			if err != nil {
+				return err
+			}
+			g.referenceId(component.Id, r)
+
+			continue
+		}
+
+		r, err := g.add(ctx, "keycloak_ldap_user_federation", component.Name, importId)
+		if err != nil {
+			return err
+		}
+		g.referenceId(component.Id, r)
+
+		mappers, err := g.keycloakClient.ListComponents(ctx, realm.Realm, component.Id, "org.keycloak.storage.ldap.mappers.LDAPStorageMapper")
+		if err != nil {
+			return err
+		}
+
+		for _, mapper := range mappers {
+			resourceType, ok := generateLdapMapperResourceTypes[mapper.ProviderId]
+			if !ok {
+				resourceType = "keycloak_ldap_custom_mapper"
+			}
+
+			_, err = g.add(ctx, resourceType, component.Name+"_"+mapper.Name, fmt.Sprintf("%s/%s/%s", realm.Realm, component.Id, mapper.Id))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *realmConfigurationGenerator) walkKeystores(ctx context.Context, realm *keycloak.Realm) error {
+	components, err := g.keycloakClient.ListComponents(ctx, realm.Realm, realm.Id, "org.keycloak.keys.KeyProvider")
+	if err != nil {
+		return err
+	}
+
+	for _, component := range components {
+		resourceType, ok := generateKeystoreResourceTypes[component.ProviderId]
+		if !ok {
+			g.skip("keystore", component.Name, fmt.Sprintf("provider %s is not supported", component.ProviderId))
+			continue
+		}
+
+		_, err = g.add(ctx, resourceType, component.Name, fmt.Sprintf("%s/%s", realm.Realm, component.Id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Imports and reads an object the same way `terraform import` would, and queues it for rendering
+func (g *realmConfigurationGenerator) add(ctx context.Context, resourceType, name, importId string) (*generatedResource, error) {
+	resource := g.resources[resourceType]
+
+	data := resource.Data(nil)
+	data.SetId(importId)
+
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		imported, err := resource.Importer.StateContext(ctx, data, g.keycloakClient)
+		if err != nil {
+			return nil, fmt.Errorf("error importing %s %s: %s", resourceType, importId, err)
+		}
+
+		data = imported[0]
+	}
+
+	diags := resource.ReadContext(ctx, data, g.keycloakClient)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error reading %s %s: %s", resourceType, importId, diags[0].Summary)
+	}
+
+	r := &generatedResource{
+		resourceType: resourceType,
+		name:         g.uniqueName(resourceType, name),
+		importId:     importId,
+		data:         data,
+	}
+
+	g.generated = append(g.generated, r)
+
+	return r, nil
+}
+
+func (g *realmConfigurationGenerator) skip(objectType, name, reason string) {
+	g.skipped = append(g.skipped, fmt.Sprintf("%s %q: %s", objectType, name, reason))
+}
+
+func (g *realmConfigurationGenerator) referenceId(id string, r *generatedResource) {
+	g.idReferences[id] = r.address() + ".id"
+}
+
+func (g *realmConfigurationGenerator) referenceName(kind, name string, r *generatedResource, attribute string) {
+	if _, ok := g.nameReferences[kind]; !ok {
+		g.nameReferences[kind] = make(map[string]string)
+	}
+
+	g.nameReferences[kind][name] = r.address() + "." + attribute
+}
+
+// Returns an expression referencing another generated resource, or an empty string if the value should be written as is
+func (g *realmConfigurationGenerator) reference(r *generatedResource, attribute, value string) string {
+	kind, ok := generateNameReferenceKinds[r.resourceType+"."+attribute]
+	if !ok {
+		kind = generateNameReferenceKinds[attribute]
+	}
+
+	expression, ok := g.nameReferences[kind][value]
+	if !ok {
+		expression = g.idReferences[value]
+	}
+
+	if strings.HasPrefix(expression, r.address()+".") {
+		return ""
+	}
+
+	return expression
+}
+
+func (g *realmConfigurationGenerator) uniqueName(resourceType, name string) string {
+	name = generateInvalidNameCharacters.ReplaceAllString(strings.ToLower(name), "_")
+	name = strings.Trim(name, "_")
+
+	if name == "" {
+		name = "unnamed"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	unique := name
+	for i := 2; g.names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+
+	g.names[resourceType+"."+unique] = true
+
+	return unique
+}
+
+func (g *realmConfigurationGenerator) render(realmName string) []byte {
+	// the resources are rendered first, since rendering them collects the variables that are written before them
+	resourcesFile := hclwrite.NewEmptyFile()
+	resourcesBody := resourcesFile.Body()
+
+	for _, r := range g.generated {
+		resourcesBody.AppendNewline()
+
+		importBody := resourcesBody.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", generateTraversal(r.address()))
+		importBody.SetAttributeValue("id", cty.StringVal(r.importId))
+
+		resourcesBody.AppendNewline()
+
+		resourceBody := resourcesBody.AppendNewBlock("resource", []string{r.resourceType, r.name}).Body()
+		g.renderBody(resourceBody, r, g.resources[r.resourceType].Schema, r.data.Get, generateOmittedAttributes[r.resourceType])
+
+		if len(r.dependsOn) != 0 {
+			var dependencies []hclwrite.Tokens
+			for _, dependency := range r.dependsOn {
+				dependencies = append(dependencies, hclwrite.TokensForTraversal(generateTraversal(dependency)))
+			}
+
+			resourceBody.AppendNewline()
+			resourceBody.SetAttributeRaw("depends_on", hclwrite.TokensForTuple(dependencies))
+		}
+	}
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	body.AppendUnstructuredTokens(generateCommentTokens(fmt.Sprintf("Generated from the %s realm", realmName)))
+	for _, skipped := range g.skipped {
+		body.AppendUnstructuredTokens(generateCommentTokens("Skipped " + skipped))
+	}
+
+	for _, v := range g.variables {
+		body.AppendNewline()
+
+		variableBody := body.AppendNewBlock("variable", []string{v.name}).Body()
+		variableBody.SetAttributeValue("description", cty.StringVal(v.description))
+		variableBody.SetAttributeTraversal("type", generateTraversal(v.variableType))
+		variableBody.SetAttributeValue("sensitive", cty.True)
+	}
+
+	body.AppendUnstructuredTokens(resourcesFile.BuildTokens(nil))
+
+	return hclwrite.Format(file.Bytes())
+}
+
+func (g *realmConfigurationGenerator) renderBody(body *hclwrite.Body, r *generatedResource, schemaMap map[string]*schema.Schema, get func(string) interface{}, omitted []string) {
+	var blocks []string
+
+	for _, key := range generateSortedAttributes(schemaMap) {
+		attributeSchema := schemaMap[key]
+
+		if attributeSchema.Computed && !attributeSchema.Optional && !attributeSchema.Required {
+			continue
+		}
+		if attributeSchema.Deprecated != "" || stringSliceContains(omitted, key) {
+			continue
+		}
+
+		value := get(key)
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+
+		if !attributeSchema.Required && (generateIsZero(value) || reflect.DeepEqual(value, attributeSchema.Default)) {
+			continue
+		}
+
+		// sensitive values that are set must be provided again, unless they are computed when they are not configured
+		if attributeSchema.Sensitive && (attributeSchema.Required || !attributeSchema.Computed) {
+			body.SetAttributeTraversal(key, generateTraversal("var."+g.variable(r, key, attributeSchema)))
+			continue
+		}
+
+		if attributeSchema.Sensitive {
+			body.AppendUnstructuredTokens(generateCommentTokens(fmt.Sprintf("%s is sensitive and was not exported", key)))
+			continue
+		}
+
+		if _, ok := attributeSchema.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, key)
+			continue
+		}
+
+		body.SetAttributeRaw(key, g.valueTokens(r, key, value))
+	}
+
+	for _, key := range blocks {
+		elemSchema := schemaMap[key].Elem.(*schema.Resource).Schema
+
+		elements := get(key)
+		if set, ok := elements.(*schema.Set); ok {
+			elements = set.List()
+		}
+
+		for _, element := range elements.([]interface{}) {
+			values, ok := element.(map[string]interface{})
+			if !ok || generateIsZero(values) {
+				continue
+			}
+
+			body.AppendNewline()
+			blockBody := body.AppendNewBlock(key, nil).Body()
+			g.renderBody(blockBody, r, elemSchema, func(k string) interface{} { return values[k] }, nil)
+		}
+	}
+}
+
+// Adds a variable for a sensitive attribute that has to be set, so that its value can be provided when the generated
+// configuration is applied, and returns the name of the variable
+func (g *realmConfigurationGenerator) variable(r *generatedResource, attribute string, attributeSchema *schema.Schema) string {
+	variableType := "string"
+	switch attributeSchema.Type {
+	case schema.TypeBool:
+		variableType = "bool"
+	case schema.TypeInt, schema.TypeFloat:
+		variableType = "number"
+	}
+
+	v := &generatedVariable{
+		name:         g.uniqueName("variable", r.name+"_"+attribute),
+		description:  fmt.Sprintf("The %s of %s, which is sensitive and was not exported", attribute, r.address()),
+		variableType: variableType,
+	}
+
+	g.variables = append(g.variables, v)
+
+	return v.name
+}
+
+func (g *realmConfigurationGenerator) valueTokens(r *generatedResource, attribute string, value interface{}) hclwrite.Tokens {
+	switch v := value.(type) {
+	case string:
+		if expression := g.reference(r, attribute, v); expression != "" {
+			return hclwrite.TokensForTraversal(generateTraversal(expression))
+		}
+
+		return hclwrite.TokensForValue(cty.StringVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case []interface{}:
+		var elements []hclwrite.Tokens
+		for _, element := range v {
+			elements = append(elements, g.valueTokens(r, attribute, element))
+		}
+
+		return hclwrite.TokensForTuple(elements)
+	case map[string]interface{}:
+		var sortedKeys []string
+		for key := range v {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+
+		var attributes []hclwrite.ObjectAttrTokens
+		for _, key := range sortedKeys {
+			attributes = append(attributes, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: g.valueTokens(r, "", v[key]),
+			})
+		}
+
+		return hclwrite.TokensForObject(attributes)
+	default:
+		return hclwrite.TokensForValue(cty.StringVal(fmt.Sprintf("%v", v)))
+	}
+}
+
+// Identifying attributes are written first, followed by everything else in alphabetical order
+func generateSortedAttributes(schemaMap map[string]*schema.Schema) []string {
+	leading := []string{"realm_id", "realm", "name", "alias", "client_id"}
+
+	var sorted []string
+	for _, key := range leading {
+		if _, ok := schemaMap[key]; ok {
+			sorted = append(sorted, key)
+		}
+	}
+
+	var rest []string
+	for key := range schemaMap {
+		if !stringSliceContains(leading, key) {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(sorted, rest...)
+}
+
+func generateIsZero(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, element := range v {
+			if set, ok := element.(*schema.Set); ok {
+				element = set.List()
+			}
+			if !generateIsZero(element) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.ValueOf(value).IsZero()
+	}
+}
+
+func generateTraversal(expression string) hcl.Traversal {
+	parts := strings.Split(expression, ".")
+
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}}
+	for _, part := range parts[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: part})
+	}
+
+	return traversal
+}
+
+func generateCommentTokens(comment string) hclwrite.Tokens {
+	return hclwrite.Tokens{
+		{
+			Type:  hclsyntax.TokenComment,
+			Bytes: []byte(fmt.Sprintf("# %s\n", comment)),
+		},
+	}
+}
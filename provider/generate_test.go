@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakGenerateRealmConfiguration_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGenerateRealmConfiguration_basic(realmName, clientId, groupName),
+				Check: testAccCheckKeycloakGeneratedRealmConfigurationMatches(realmName, []string{
+					fmt.Sprintf(`resource "keycloak_realm" "%s"`, strings.ReplaceAll(realmName, "-", "_")),
+					fmt.Sprintf(`id\s+= "%s"`, realmName),
+					fmt.Sprintf(`client_id\s+= "%s"`, clientId),
+					fmt.Sprintf(`realm_id\s+= keycloak_realm\.%s\.id`, strings.ReplaceAll(realmName, "-", "_")),
+					fmt.Sprintf(`parent_id\s+= keycloak_group\.%s\.id`, strings.ReplaceAll(groupName, "-", "_")),
+					`resource "keycloak_role" "role"`,
+					`resource "keycloak_authentication_bindings"`,
+					`client_secret\s+= var\.idp_client_secret`,
+					`variable "idp_client_secret"`,
+				}),
+			},
+		},
+	})
+}
+
+func TestGenerateResourceTypesAreRegistered(t *testing.T) {
+	t.Parallel()
+
+	for _, resourceTypes := range []map[string]string{
+		generateIdentityProviderResourceTypes,
+		generateLdapMapperResourceTypes,
+		generateKeystoreResourceTypes,
+	} {
+		for providerId, resourceType := range resourceTypes {
+			if _, ok := testAccProvider.ResourcesMap[resourceType]; !ok {
+				t.Errorf("resource type %s for provider %s is not registered", resourceType, providerId)
+			}
+		}
+	}
+}
+
+func TestGenerateRealmConfigurationSensitiveAttributes(t *testing.T) {
+	t.Parallel()
+
+	g := &realmConfigurationGenerator{
+		resources:      testAccProvider.ResourcesMap,
+		names:          make(map[string]bool),
+		idReferences:   make(map[string]string),
+		nameReferences: make(map[string]map[string]string),
+	}
+
+	for _, r := range []struct {
+		resourceType string
+		name         string
+		values       map[string]interface{}
+	}{
+		{
+			resourceType: "keycloak_oidc_identity_provider",
+			name:         "idp",
+			values: map[string]interface{}{
+				"realm":             "my-realm",
+				"alias":             "idp",
+				"authorization_url": "https://example.com/auth",
+				"token_url":         "https://example.com/token",
+				"client_id":         "client",
+				"client_secret":     "**********",
+			},
+		},
+		{
+			resourceType: "keycloak_ldap_user_federation",
+			name:         "ldap",
+			values: map[string]interface{}{
+				"realm_id":        "my-realm",
+				"name":            "ldap",
+				"bind_dn":         "cn=admin",
+				"bind_credential": "**********",
+			},
+		},
+	} {
+		data := g.resources[r.resourceType].Data(nil)
+		for key, value := range r.values {
+			if err := data.Set(key, value); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		g.generated = append(g.generated, &generatedResource{
+			resourceType: r.resourceType,
+			name:         g.uniqueName(r.resourceType, r.name),
+			importId:     "my-realm/" + r.name,
+			data:         data,
+		})
+	}
+
+	configuration := g.render("my-realm")
+
+	if _, diags := hclwrite.ParseConfig(configuration, "generated.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("generated configuration is not valid HCL: %s", diags.Error())
+	}
+
+	for _, pattern := range []string{
+		`variable "idp_client_secret" {`,
+		`variable "ldap_bind_credential" {`,
+		`sensitive\s+= true`,
+		`client_secret\s+= var\.idp_client_secret`,
+		`bind_credential\s+= var\.ldap_bind_credential`,
+	} {
+		if !regexp.MustCompile(pattern).Match(configuration) {
+			t.Errorf("expected generated configuration to match %s, got:\n%s", pattern, configuration)
+		}
+	}
+
+	if strings.Contains(string(configuration), "**********") {
+		t.Errorf("expected generated configuration to not contain masked secrets, got:\n%s", configuration)
+	}
+}
+
+func testAccCheckKeycloakGeneratedRealmConfigurationMatches(realmName string, patterns []string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		configuration, err := GenerateRealmConfiguration(testCtx, keycloakClient, realmName)
+		if err != nil {
+			return err
+		}
+
+		_, diags := hclwrite.ParseConfig(configuration, "generated.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			return fmt.Errorf("generated configuration is not valid HCL: %s", diags.Error())
+		}
+
+		for _, pattern := range patterns {
+			if !regexp.MustCompile(pattern).Match(configuration) {
+				return fmt.Errorf("expected generated configuration to match %s, got:\n%s", pattern, configuration)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakGenerateRealmConfiguration_basic(realm, clientId, group string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = keycloak_realm.realm.id
+	name     = "role"
+}
+
+resource "keycloak_group" "parent" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group" "child" {
+	realm_id  = keycloak_realm.realm.id
+	parent_id = keycloak_group.parent.id
+	name      = "child"
+}
+
+resource "keycloak_oidc_identity_provider" "idp" {
+	realm             = keycloak_realm.realm.id
+	alias             = "idp"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "client"
+	client_secret     = "secret"
+}
+	`, realm, clientId, group)
+}
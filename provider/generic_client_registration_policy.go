@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+var clientRegistrationPolicySubTypes = []string{"anonymous", "authenticated"}
+
+type clientRegistrationPolicyConfigGetterFunc func(data *schema.ResourceData) map[string][]string
+type clientRegistrationPolicyConfigSetterFunc func(data *schema.ResourceData, config map[string][]string) error
+
+// resourceKeycloakClientRegistrationPolicy builds a resource for the client registration policies of one provider. The
+// given schema and functions handle the provider specific configuration.
+func resourceKeycloakClientRegistrationPolicy(providerId string, configSchema map[string]*schema.Schema, getConfig clientRegistrationPolicyConfigGetterFunc, setConfig clientRegistrationPolicyConfigSetterFunc) *schema.Resource {
+	policySchema := map[string]*schema.Schema{
+		"realm_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Display name of the policy in the admin console.",
+		},
+		"sub_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(clientRegistrationPolicySubTypes, false),
+			Description:  "Whether the policy applies to anonymous or authenticated client registration requests.",
+		},
+	}
+
+	for key, value := range configSchema {
+		policySchema[key] = value
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakClientRegistrationPolicyCreate(providerId, getConfig, setConfig),
+		ReadContext:   resourceKeycloakClientRegistrationPolicyRead(providerId, setConfig),
+		UpdateContext: resourceKeycloakClientRegistrationPolicyUpdate(providerId, getConfig, setConfig),
+		DeleteContext: resourceKeycloakClientRegistrationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakClientRegistrationPolicyImport,
+		},
+		Schema: policySchema,
+	}
+}
+
+func getClientRegistrationPolicyFromData(providerId string, data *schema.ResourceData, getConfig clientRegistrationPolicyConfigGetterFunc) *keycloak.ClientRegistrationPolicy {
+	return &keycloak.ClientRegistrationPolicy{
+		Id:         data.Id(),
+		Name:       data.Get("name").(string),
+		RealmId:    data.Get("realm_id").(string),
+		ProviderId: providerId,
+		SubType:    data.Get("sub_type").(string),
+		Config:     getConfig(data),
+	}
+}
+
+func setClientRegistrationPolicyData(data *schema.ResourceData, policy *keycloak.ClientRegistrationPolicy, setConfig clientRegistrationPolicyConfigSetterFunc) error {
+	data.SetId(policy.Id)
+
+	data.Set("name", policy.Name)
+	data.Set("realm_id", policy.RealmId)
+	data.Set("sub_type", policy.SubType)
+
+	return setConfig(data, policy.Config)
+}
+
+func resourceKeycloakClientRegistrationPolicyCreate(providerId string, getConfig clientRegistrationPolicyConfigGetterFunc, setConfig clientRegistrationPolicyConfigSetterFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		policy := getClientRegistrationPolicyFromData(providerId, data, getConfig)
+
+		err := keycloakClient.NewClientRegistrationPolicy(ctx, policy)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		data.SetId(policy.Id)
+
+		return resourceKeycloakClientRegistrationPolicyRead(providerId, setConfig)(ctx, data, meta)
+	}
+}
+
+func resourceKeycloakClientRegistrationPolicyRead(providerId string, setConfig clientRegistrationPolicyConfigSetterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		realmId := data.Get("realm_id").(string)
+
+		policy, err := keycloakClient.GetClientRegistrationPolicy(ctx, realmId, data.Id(), providerId)
+		if err != nil {
+			return handleNotFoundError(ctx, err, data)
+		}
+
+		return diag.FromErr(setClientRegistrationPolicyData(data, policy, setConfig))
+	}
+}
+
+func resourceKeycloakClientRegistrationPolicyUpdate(providerId string, getConfig clientRegistrationPolicyConfigGetterFunc, setConfig clientRegistrationPolicyConfigSetterFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		policy := getClientRegistrationPolicyFromData(providerId, data, getConfig)
+
+		err := keycloakClient.UpdateClientRegistrationPolicy(ctx, policy)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diag.FromErr(setClientRegistrationPolicyData(data, policy, setConfig))
+	}
+}
+
+func resourceKeycloakClientRegistrationPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteClientRegistrationPolicy(ctx, realmId, data.Id()))
+}
+
+func resourceKeycloakClientRegistrationPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{policyId}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getClientRegistrationPolicyBoolConfig(config map[string][]string, key string) (bool, error) {
+	if len(config[key]) == 0 || config[key][0] == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(config[key][0])
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func testAccCheckKeycloakClientRegistrationPolicyExists(resourceName, providerId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakClientRegistrationPolicyFromState(s, resourceName, providerId)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyFetch(resourceName, providerId string, policy *keycloak.ClientRegistrationPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedPolicy, err := getKeycloakClientRegistrationPolicyFromState(s, resourceName, providerId)
+		if err != nil {
+			return err
+		}
+
+		policy.Id = fetchedPolicy.Id
+		policy.RealmId = fetchedPolicy.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyDestroy(resourceType, providerId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			policy, _ := keycloakClient.GetClientRegistrationPolicy(testCtx, realm, id, providerId)
+			if policy != nil {
+				return fmt.Errorf("client registration policy with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakClientRegistrationPolicyFromState(s *terraform.State, resourceName, providerId string) (*keycloak.ClientRegistrationPolicy, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	policy, err := keycloakClient.GetClientRegistrationPolicy(testCtx, realm, id, providerId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client registration policy with id %s: %s", id, err)
+	}
+
+	return policy, nil
+}
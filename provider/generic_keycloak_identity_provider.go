@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,9 +23,11 @@ func resourceKeycloakIdentityProvider() *schema.Resource {
 	return &schema.Resource{
 		DeleteContext: resourceKeycloakIdentityProviderDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceKeycloakIdentityProviderImport,
+			StateContext: importWithDeletionProtection(resourceKeycloakIdentityProviderImport),
 		},
+		CustomizeDiff: customizeDiffDeletionProtection,
 		Schema: map[string]*schema.Schema{
+			"deletion_protection": deletionProtectionSchema(),
 			"alias": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -169,6 +170,10 @@ func setIdentityProviderData(data *schema.ResourceData, identityProvider *keyclo
 }
 
 func resourceKeycloakIdentityProviderDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(data, "identity provider"); diags.HasError() {
+		return diags
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realm := data.Get("realm").(string)
@@ -177,16 +182,28 @@ func resourceKeycloakIdentityProviderDelete(ctx context.Context, data *schema.Re
 	return diag.FromErr(keycloakClient.DeleteIdentityProvider(ctx, realm, alias))
 }
 
-func resourceKeycloakIdentityProviderImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakIdentityProviderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
 	parts := strings.Split(d.Id(), "/")
 
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realm}}/{{identityProviderAlias}}")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{identityProviderAlias}}", "{{realm}}/{{identityProviderInternalId}}")
+	}
+
+	realm, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
 	}
 
-	d.Set("realm", parts[0])
-	d.Set("alias", parts[1])
-	d.SetId(parts[1])
+	alias, err := resolveImportIdentityProviderAlias(ctx, keycloakClient, realm, parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm", realm)
+	d.Set("alias", alias)
+	d.SetId(alias)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -231,6 +248,13 @@ func resourceKeycloakIdentityProviderUpdate(getIdentityProviderFromData identity
 			return diag.FromErr(err)
 		}
 
+		// the identity provider may have been linked to an organization by a keycloak_organization_identity_provider resource
+		liveIdentityProvider, err := keycloakClient.GetIdentityProvider(ctx, identityProvider.Realm, identityProvider.Alias)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		identityProvider.KeepOrganizationLink(liveIdentityProvider)
+
 		err = keycloakClient.UpdateIdentityProvider(ctx, identityProvider)
 		if err != nil {
 			return diag.FromErr(err)
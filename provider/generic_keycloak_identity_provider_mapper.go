@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"strings"
 
@@ -79,16 +78,33 @@ func resourceKeycloakIdentityProviderMapperDelete(ctx context.Context, data *sch
 	return diag.FromErr(keycloakClient.DeleteIdentityProviderMapper(ctx, realm, alias, id))
 }
 
-func resourceKeycloakIdentityProviderMapperImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakIdentityProviderMapperImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
 	parts := strings.Split(d.Id(), "/")
 
-	if len(parts) != 3 {
-		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realm}}/{{identityProviderAlias}}/{{identityProviderMapperId}}")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, invalidImportError("{{realm}}/{{identityProviderAlias}}/{{identityProviderMapperId}}", "{{realm}}/{{identityProviderAlias}}/{{identityProviderMapperName}}")
+	}
+
+	realm, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	alias, err := resolveImportIdentityProviderAlias(ctx, keycloakClient, realm, parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := resolveImportIdentityProviderMapperId(ctx, keycloakClient, realm, alias, parts[2])
+	if err != nil {
+		return nil, err
 	}
 
-	d.Set("realm", parts[0])
-	d.Set("identity_provider_alias", parts[1])
-	d.SetId(parts[2])
+	d.Set("realm", realm)
+	d.Set("identity_provider_alias", alias)
+	d.SetId(id)
 
 	return []*schema.ResourceData{d}, nil
 }
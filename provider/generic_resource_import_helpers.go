@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// The helpers in this file allow import IDs to reference objects by their human-readable names (realm name, client ID,
+// group path, identity provider alias, mapper name) as well as by their internal IDs.
+
+func invalidImportError(formats ...string) error {
+	return fmt.Errorf("Invalid import. Supported import formats: %s", strings.Join(formats, ", "))
+}
+
+func notFoundImportError(objectType, name string, err error) error {
+	return fmt.Errorf("Invalid import. Unable to find %s %s: %s", objectType, name, err)
+}
+
+// Accepts a realm name or a realm's internal ID and returns the realm name, which is what resources use as realm_id
+func resolveImportRealmName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realm string) (string, error) {
+	existingRealm, err := keycloakClient.GetRealm(ctx, realm)
+	if err == nil {
+		return existingRealm.Realm, nil
+	}
+
+	if !keycloak.ErrorIs404(err) {
+		return "", err
+	}
+
+	realms, err := keycloakClient.GetRealms(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range realms {
+		if r.Id == realm {
+			return r.Realm, nil
+		}
+	}
+
+	return "", notFoundImportError("realm", realm, fmt.Errorf("no realm with this name or id exists"))
+}
+
+// Accepts a client's internal ID or its client ID and returns the internal ID
+func resolveImportClientId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, client string) (string, error) {
+	existingClient, err := keycloakClient.GetGenericClient(ctx, realmId, client)
+	if err == nil {
+		return existingClient.Id, nil
+	}
+
+	if !keycloak.ErrorIs404(err) {
+		return "", err
+	}
+
+	existingClient, err = keycloakClient.GetGenericClientByClientId(ctx, realmId, client)
+	if err != nil {
+		return "", notFoundImportError("client", client, err)
+	}
+
+	return existingClient.Id, nil
+}
+
+// Accepts a group's ID or its full path (starting with a slash) and returns the group ID
+func resolveImportGroupId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, group string) (string, error) {
+	var existingGroup *keycloak.Group
+	var err error
+
+	if strings.HasPrefix(group, "/") {
+		existingGroup, err = keycloakClient.GetGroupByPath(ctx, realmId, group)
+	} else {
+		existingGroup, err = keycloakClient.GetGroup(ctx, realmId, group)
+	}
+
+	if err != nil {
+		return "", notFoundImportError("group", group, err)
+	}
+
+	return existingGroup.Id, nil
+}
+
+// Accepts an identity provider's alias or its internal ID and returns the alias
+func resolveImportIdentityProviderAlias(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, identityProvider string) (string, error) {
+	existingIdentityProvider, err := keycloakClient.GetIdentityProvider(ctx, realmId, identityProvider)
+	if err == nil {
+		return existingIdentityProvider.Alias, nil
+	}
+
+	if !keycloak.ErrorIs404(err) {
+		return "", err
+	}
+
+	identityProviders, err := keycloakClient.GetIdentityProviders(ctx, realmId)
+	if err != nil {
+		return "", err
+	}
+
+	for _, i := range identityProviders {
+		if i.InternalId == identityProvider {
+			return i.Alias, nil
+		}
+	}
+
+	return "", notFoundImportError("identity provider", identityProvider, fmt.Errorf("no identity provider with this alias or internal id exists"))
+}
+
+// Accepts an identity provider mapper's ID or its name and returns the mapper ID
+func resolveImportIdentityProviderMapperId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, alias, mapper string) (string, error) {
+	mappers, err := keycloakClient.GetIdentityProviderMappers(ctx, realmId, alias)
+	if err != nil {
+		return "", err
+	}
+
+	for _, m := range mappers {
+		if m.Id == mapper || m.Name == mapper {
+			return m.Id, nil
+		}
+	}
+
+	return "", notFoundImportError("identity provider mapper", mapper, fmt.Errorf("no mapper with this name or id exists for identity provider %s", alias))
+}
+
+// Used by resources that manage a single settings object per realm, where the ID of the resource is the realm name
+func resourceKeycloakRealmSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if d.Id() == "" || strings.Contains(d.Id(), "/") {
+		return nil, invalidImportError("{{realm}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.SetId(realmId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Used by resources that manage the scopes attached to a client, where the ID of the resource is {{realm}}/{{clientInternalId}}
+func resourceKeycloakClientScopesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{clientId}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	clientId, err := resolveImportClientId(ctx, keycloakClient, realmId, parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("client_id", clientId)
+	d.SetId(fmt.Sprintf("%s/%s", realmId, clientId))
+
+	return []*schema.ResourceData{d}, nil
+}
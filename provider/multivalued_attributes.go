@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Users, groups and roles have attributes with multiple values. They can be set with the attributes map, where the
+// values are joined with MULTIVALUE_ATTRIBUTE_SEPARATOR, or with multivalued_attributes blocks, which take a list of
+// values and allow values that contain the separator. Both can be used together as long as every attribute is only set
+// in one of them. When reading, attributes that are configured in multivalued_attributes are set there, and all other
+// attributes are set in the attributes map.
+
+func multivaluedAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMultivaluedAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func customizeDiffValidateMultivaluedAttributes(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("attributes") || !d.NewValueKnown("multivalued_attributes") {
+		return nil
+	}
+
+	attributes := d.Get("attributes").(map[string]interface{})
+
+	for _, v := range d.Get("multivalued_attributes").(*schema.Set).List() {
+		name := v.(map[string]interface{})["name"].(string)
+		if _, ok := attributes[name]; ok {
+			return fmt.Errorf("validation error: attribute %s cannot be set in both attributes and multivalued_attributes", name)
+		}
+	}
+
+	return nil
+}
+
+func getAttributesFromData(data *schema.ResourceData) map[string][]string {
+	attributes := map[string][]string{}
+
+	if v, ok := data.GetOk("attributes"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+	}
+
+	if v, ok := data.GetOk("multivalued_attributes"); ok {
+		for _, multivaluedAttribute := range v.(*schema.Set).List() {
+			multivaluedAttributeData := multivaluedAttribute.(map[string]interface{})
+			attributes[multivaluedAttributeData["name"].(string)] = interfaceSliceToStringSlice(multivaluedAttributeData["values"].([]interface{}))
+		}
+	}
+
+	return attributes
+}
+
+func setAttributesData(data *schema.ResourceData, attributes map[string][]string) {
+	multivaluedAttributeNames := map[string]bool{}
+	if v, ok := data.GetOk("multivalued_attributes"); ok {
+		for _, multivaluedAttribute := range v.(*schema.Set).List() {
+			multivaluedAttributeNames[multivaluedAttribute.(map[string]interface{})["name"].(string)] = true
+		}
+	}
+
+	joinedAttributes := map[string]string{}
+	multivaluedAttributes := map[string][]string{}
+	for key, values := range attributes {
+		if multivaluedAttributeNames[key] {
+			multivaluedAttributes[key] = values
+		} else {
+			joinedAttributes[key] = strings.Join(values, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+	}
+
+	data.Set("attributes", joinedAttributes)
+	data.Set("multivalued_attributes", flattenMultivaluedAttributes(multivaluedAttributes))
+}
+
+// Data sources set every attribute in both attributes and multivalued_attributes.
+func setDataSourceAttributesData(data *schema.ResourceData, attributes map[string][]string) {
+	joinedAttributes := map[string]string{}
+	for key, values := range attributes {
+		joinedAttributes[key] = strings.Join(values, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	data.Set("attributes", joinedAttributes)
+	data.Set("multivalued_attributes", flattenMultivaluedAttributes(attributes))
+}
+
+func flattenMultivaluedAttributes(attributes map[string][]string) []interface{} {
+	var names []string
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var multivaluedAttributes []interface{}
+	for _, name := range names {
+		multivaluedAttributes = append(multivaluedAttributes, map[string]interface{}{
+			"name":   name,
+			"values": attributes[name],
+		})
+	}
+
+	return multivaluedAttributes
+}
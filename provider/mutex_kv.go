@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Some Keycloak resources are modified by several Terraform resources, such as the executions of a flow or the default
+// and optional scopes of a client. Keycloak does not protect these read-modify-write operations, so when Terraform
+// applies sibling resources in parallel they can interleave and produce non-deterministic results. Resources acquire a
+// lock on the shared parent around their mutations so that those operations are serialized.
+
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock acquires the lock for the given key, creating it if it does not exist yet.
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+
+	return mutex
+}
+
+var keycloakMutexKV = newMutexKV()
+
+func realmMutexKey(realmId string) string {
+	return fmt.Sprintf("realm/%s", realmId)
+}
+
+func authenticationFlowMutexKey(realmId, flowAlias string) string {
+	return fmt.Sprintf("realm/%s/authentication-flow/%s", realmId, flowAlias)
+}
+
+func clientMutexKey(realmId, clientId string) string {
+	return fmt.Sprintf("realm/%s/client/%s", realmId, clientId)
+}
+
+func componentMutexKey(realmId, componentId string) string {
+	return fmt.Sprintf("realm/%s/component/%s", realmId, componentId)
+}
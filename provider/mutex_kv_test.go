@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMutexKVSameKeyIsExclusive(t *testing.T) {
+	t.Parallel()
+
+	m := newMutexKV()
+	key := realmMutexKey("my-realm")
+
+	m.Lock(key)
+
+	acquired := make(chan struct{})
+	go func() {
+		m.Lock(key)
+		close(acquired)
+		m.Unlock(key)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatalf("expected lock for key %s to be held until it is unlocked", key)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	m.Unlock(key)
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected lock for key %s to be acquired after it was unlocked", key)
+	}
+}
+
+func TestMutexKVDifferentKeysAreIndependent(t *testing.T) {
+	t.Parallel()
+
+	m := newMutexKV()
+	key := realmMutexKey("my-realm")
+	otherKey := realmMutexKey("other-realm")
+
+	m.Lock(key)
+	defer m.Unlock(key)
+
+	acquired := make(chan struct{})
+	go func() {
+		m.Lock(otherKey)
+		close(acquired)
+		m.Unlock(otherKey)
+	}()
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected lock for key %s to not be blocked by the lock for key %s", otherKey, key)
+	}
+}
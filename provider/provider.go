@@ -20,8 +20,13 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_openid_client_service_account_user": dataSourceKeycloakOpenidClientServiceAccountUser(),
 			"keycloak_realm":                              dataSourceKeycloakRealm(),
 			"keycloak_realm_keys":                         dataSourceKeycloakRealmKeys(),
+			"keycloak_realm_localization":                 dataSourceKeycloakRealmLocalization(),
+			"keycloak_realm_login_events":                 dataSourceKeycloakRealmLoginEvents(),
+			"keycloak_realm_admin_events":                 dataSourceKeycloakRealmAdminEvents(),
+			"keycloak_organization":                       dataSourceKeycloakOrganization(),
 			"keycloak_role":                               dataSourceKeycloakRole(),
 			"keycloak_user":                               dataSourceKeycloakUser(),
+			"keycloak_users":                              dataSourceKeycloakUsers(),
 			"keycloak_user_realm_roles":                   dataSourceKeycloakUserRealmRoles(),
 			"keycloak_saml_client_installation_provider":  dataSourceKeycloakSamlClientInstallationProvider(),
 			"keycloak_saml_client":                        dataSourceKeycloakSamlClient(),
@@ -33,12 +38,36 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_realm":                                             resourceKeycloakRealm(),
 			"keycloak_realm_events":                                      resourceKeycloakRealmEvents(),
 			"keycloak_realm_keystore_aes_generated":                      resourceKeycloakRealmKeystoreAesGenerated(),
+			"keycloak_realm_keystore_ecdh_generated":                     resourceKeycloakRealmKeystoreEcdhGenerated(),
 			"keycloak_realm_keystore_ecdsa_generated":                    resourceKeycloakRealmKeystoreEcdsaGenerated(),
+			"keycloak_realm_keystore_eddsa_generated":                    resourceKeycloakRealmKeystoreEddsaGenerated(),
 			"keycloak_realm_keystore_hmac_generated":                     resourceKeycloakRealmKeystoreHmacGenerated(),
 			"keycloak_realm_keystore_java_keystore":                      resourceKeycloakRealmKeystoreJavaKeystore(),
 			"keycloak_realm_keystore_rsa":                                resourceKeycloakRealmKeystoreRsa(),
 			"keycloak_realm_keystore_rsa_generated":                      resourceKeycloakRealmKeystoreRsaGenerated(),
+			"keycloak_realm_keystore_rsa_enc_generated":                  resourceKeycloakRealmKeystoreRsaEncGenerated(),
 			"keycloak_realm_user_profile":                                resourceKeycloakRealmUserProfile(),
+			"keycloak_realm_user_profile_attribute":                      resourceKeycloakRealmUserProfileAttribute(),
+			"keycloak_realm_user_profile_group":                          resourceKeycloakRealmUserProfileGroup(),
+			"keycloak_realm_user_profile_unmanaged_attribute_policy":     resourceKeycloakRealmUserProfileUnmanagedAttributePolicy(),
+			"keycloak_realm_smtp_server":                                 resourceKeycloakRealmSmtpServer(),
+			"keycloak_realm_security_defenses":                           resourceKeycloakRealmSecurityDefenses(),
+			"keycloak_realm_token_settings":                              resourceKeycloakRealmTokenSettings(),
+			"keycloak_realm_otp_policy":                                  resourceKeycloakRealmOtpPolicy(),
+			"keycloak_realm_webauthn_policy":                             resourceKeycloakRealmWebAuthnPolicy(),
+			"keycloak_realm_localization":                                resourceKeycloakRealmLocalization(),
+			"keycloak_realm_key_rotation":                                resourceKeycloakRealmKeyRotation(),
+			"keycloak_realm_client_policy_profile":                       resourceKeycloakRealmClientPolicyProfile(),
+			"keycloak_realm_client_policy":                               resourceKeycloakRealmClientPolicy(),
+			"keycloak_realm_client_initial_access_token":                 resourceKeycloakRealmClientInitialAccessToken(),
+			"keycloak_client_registration_trusted_hosts_policy":          resourceKeycloakClientRegistrationTrustedHostsPolicy(),
+			"keycloak_client_registration_max_clients_policy":            resourceKeycloakClientRegistrationMaxClientsPolicy(),
+			"keycloak_client_registration_protocol_mappers_policy":       resourceKeycloakClientRegistrationProtocolMappersPolicy(),
+			"keycloak_client_registration_client_scopes_policy":          resourceKeycloakClientRegistrationClientScopesPolicy(),
+			"keycloak_client_registration_consent_required_policy":       resourceKeycloakClientRegistrationConsentRequiredPolicy(),
+			"keycloak_organization":                                      resourceKeycloakOrganization(),
+			"keycloak_organization_membership":                           resourceKeycloakOrganizationMembership(),
+			"keycloak_organization_identity_provider":                    resourceKeycloakOrganizationIdentityProvider(),
 			"keycloak_required_action":                                   resourceKeycloakRequiredAction(),
 			"keycloak_group":                                             resourceKeycloakGroup(),
 			"keycloak_group_memberships":                                 resourceKeycloakGroupMemberships(),
@@ -47,9 +76,11 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_group_roles":                                       resourceKeycloakGroupRoles(),
 			"keycloak_user":                                              resourceKeycloakUser(),
 			"keycloak_user_roles":                                        resourceKeycloakUserRoles(),
+			"keycloak_user_federated_identity":                           resourceKeycloakUserFederatedIdentity(),
 			"keycloak_openid_client":                                     resourceKeycloakOpenidClient(),
 			"keycloak_openid_client_scope":                               resourceKeycloakOpenidClientScope(),
 			"keycloak_ldap_user_federation":                              resourceKeycloakLdapUserFederation(),
+			"keycloak_ldap_user_import":                                  resourceKeycloakLdapUserImport(),
 			"keycloak_ldap_user_attribute_mapper":                        resourceKeycloakLdapUserAttributeMapper(),
 			"keycloak_ldap_group_mapper":                                 resourceKeycloakLdapGroupMapper(),
 			"keycloak_ldap_role_mapper":                                  resourceKeycloakLdapRoleMapper(),
@@ -117,6 +148,7 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_user_groups":                                       resourceKeycloakUserGroups(),
 			"keycloak_group_permissions":                                 resourceKeycloakGroupPermissions(),
 			"keycloak_authentication_bindings":                           resourceKeycloakAuthenticationBindings(),
+			"keycloak_admin_api_object":                                  resourceKeycloakAdminApiObject(),
 		},
 		Schema: map[string]*schema.Schema{
 			"client_id": {
@@ -192,6 +224,24 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 					Type: schema.TypeString,
 				},
 			},
+			"deletion_protection": {
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Description: "The default value of the `deletion_protection` attribute for resources that support it, such as realms, clients, user federation providers and identity providers.",
+				Default:     false,
+			},
+			"allowed_realms": {
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the realms this provider is allowed to manage. When set, requests for any other realm fail.",
+			},
+			"denied_realms": {
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the realms this provider is not allowed to manage. Takes precedence over allowed_realms.",
+			},
 		},
 	}
 
@@ -230,8 +280,24 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			})
 		}
 
+		if keycloakClient != nil {
+			keycloakClient.SetDeletionProtection(data.Get("deletion_protection").(bool))
+
+			allowedRealms := interfaceSliceToStringSlice(data.Get("allowed_realms").([]interface{}))
+			deniedRealms := interfaceSliceToStringSlice(data.Get("denied_realms").([]interface{}))
+			if err := keycloakClient.SetRealmRestrictions(allowedRealms, deniedRealms); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "error initializing keycloak provider",
+					Detail:   err.Error(),
+				})
+			}
+		}
+
 		return keycloakClient, diags
 	}
 
+	addRealmRestrictionsToResources(provider.ResourcesMap)
+
 	return provider
 }
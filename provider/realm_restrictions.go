@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// The realms that the provider may manage are enforced by the Keycloak client on every request, which covers data
+// sources and refreshes during plan. This adds the same check to the plan of every resource with a realm attribute, so
+// that creating a resource in a realm that is not allowed fails before anything is applied.
+func addRealmRestrictionsToResources(resources map[string]*schema.Resource) {
+	for _, resource := range resources {
+		for _, attribute := range []string{"realm_id", "realm"} {
+			if attributeSchema, ok := resource.Schema[attribute]; !ok || attributeSchema.Type != schema.TypeString {
+				continue
+			}
+
+			if resource.CustomizeDiff == nil {
+				resource.CustomizeDiff = customizeDiffRealmIsManageable(attribute)
+			} else {
+				resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, customizeDiffRealmIsManageable(attribute))
+			}
+
+			break
+		}
+	}
+}
+
+func customizeDiffRealmIsManageable(attribute string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(attribute) {
+			return nil
+		}
+
+		realm := d.Get(attribute).(string)
+		if realm == "" {
+			return nil
+		}
+
+		keycloakClient, ok := meta.(*keycloak.KeycloakClient)
+		if !ok || keycloakClient == nil {
+			return nil
+		}
+
+		return keycloakClient.RealmIsManageable(realm)
+	}
+}
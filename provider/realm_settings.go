@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// Some realm settings can be managed either by keycloak_realm or by a dedicated realm settings resource, such as
+// keycloak_realm_smtp_server. The dedicated resources only update their own fields of the realm representation, and
+// keycloak_realm only sends these fields when they are configured on the realm, or when they were just removed from its
+// configuration. This allows both resources to be used together without reverting each other's changes.
+
+var realmTokenSettingsFields = map[string]string{
+	"default_signature_algorithm":              "defaultSignatureAlgorithm",
+	"revoke_refresh_token":                     "revokeRefreshToken",
+	"refresh_token_max_reuse":                  "refreshTokenMaxReuse",
+	"sso_session_idle_timeout":                 "ssoSessionIdleTimeout",
+	"sso_session_max_lifespan":                 "ssoSessionMaxLifespan",
+	"sso_session_idle_timeout_remember_me":     "ssoSessionIdleTimeoutRememberMe",
+	"sso_session_max_lifespan_remember_me":     "ssoSessionMaxLifespanRememberMe",
+	"offline_session_idle_timeout":             "offlineSessionIdleTimeout",
+	"offline_session_max_lifespan":             "offlineSessionMaxLifespan",
+	"offline_session_max_lifespan_enabled":     "offlineSessionMaxLifespanEnabled",
+	"client_session_idle_timeout":              "clientSessionIdleTimeout",
+	"client_session_max_lifespan":              "clientSessionMaxLifespan",
+	"access_token_lifespan":                    "accessTokenLifespan",
+	"access_token_lifespan_for_implicit_flow":  "accessTokenLifespanForImplicitFlow",
+	"access_code_lifespan":                     "accessCodeLifespan",
+	"access_code_lifespan_login":               "accessCodeLifespanLogin",
+	"access_code_lifespan_user_action":         "accessCodeLifespanUserAction",
+	"action_token_generated_by_user_lifespan":  "actionTokenGeneratedByUserLifespan",
+	"action_token_generated_by_admin_lifespan": "actionTokenGeneratedByAdminLifespan",
+	"oauth2_device_code_lifespan":              "oauth2DeviceCodeLifespan",
+	"oauth2_device_polling_interval":           "oauth2DevicePollingInterval",
+}
+
+// The fields of the realm representation that belong to each keycloak_realm attribute that can also be managed by a
+// realm settings resource.
+func realmSettingsFields() map[string][]string {
+	fields := map[string][]string{
+		"smtp_server":                   keycloak.RealmSmtpServerFields,
+		"security_defenses":             keycloak.RealmSecurityDefensesFields,
+		"otp_policy":                    keycloak.RealmOtpPolicyFields,
+		"web_authn_policy":              keycloak.RealmWebAuthnPolicyFields,
+		"web_authn_passwordless_policy": keycloak.RealmWebAuthnPasswordlessPolicyFields,
+	}
+
+	for attribute, field := range realmTokenSettingsFields {
+		fields[attribute] = []string{field}
+	}
+
+	return fields
+}
+
+// attributeIsConfigured reports whether the given top level attribute is set in the configuration. Blocks that are
+// omitted from the configuration are represented as empty lists rather than null values.
+func attributeIsConfigured(rawConfig cty.Value, attribute string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	value := rawConfig.GetAttr(attribute)
+	if value.IsNull() {
+		return false
+	}
+
+	if value.IsKnown() && (value.Type().IsListType() || value.Type().IsSetType()) {
+		return value.LengthInt() > 0
+	}
+
+	return true
+}
+
+// getRealmUnmanagedFields returns the fields of the realm representation that keycloak_realm should not update. The
+// token settings are computed, so they are only managed while they are configured, and keep their current value when
+// they are removed from the configuration.
+func getRealmUnmanagedFields(data *schema.ResourceData) []string {
+	rawConfig := data.GetRawConfig()
+
+	var unmanagedFields []string
+	for attribute, fields := range realmSettingsFields() {
+		if attributeIsConfigured(rawConfig, attribute) {
+			continue
+		}
+
+		if _, ok := realmTokenSettingsFields[attribute]; ok || !data.HasChange(attribute) {
+			unmanagedFields = append(unmanagedFields, fields...)
+		}
+	}
+
+	return unmanagedFields
+}
+
+// getConfiguredRealmTokenSettingsFields returns the fields of the realm representation for the token settings that are
+// set in the configuration of a resource.
+func getConfiguredRealmTokenSettingsFields(data *schema.ResourceData) []string {
+	rawConfig := data.GetRawConfig()
+
+	var fields []string
+	for attribute, field := range realmTokenSettingsFields {
+		if attributeIsConfigured(rawConfig, attribute) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// getDefaultSettings returns the default value of each attribute in the given schema, as it would be read from the
+// configuration of a block where none of them are set.
+func getDefaultSettings(settingsSchema map[string]*schema.Schema) map[string]interface{} {
+	settings := make(map[string]interface{})
+	for key, attributeSchema := range settingsSchema {
+		if attributeSchema.Type == schema.TypeSet {
+			settings[key] = schema.NewSet(schema.HashString, nil)
+		} else {
+			settings[key] = attributeSchema.Default
+		}
+	}
+
+	return settings
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakAdminApiObject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAdminApiObjectCreate,
+		ReadContext:   resourceKeycloakAdminApiObjectRead,
+		UpdateContext: resourceKeycloakAdminApiObjectUpdate,
+		DeleteContext: resourceKeycloakAdminApiObjectDelete,
+		Schema: map[string]*schema.Schema{
+			"create_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path relative to the admin API root that the body is POSTed to, e.g. /realms/my-realm/client-scopes",
+			},
+			"object_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path template of the created object. {id} is replaced with the id parsed from the Location header.",
+				ValidateFunc: validation.StringMatch(adminApiObjectIdPlaceholderRegex, fmt.Sprintf("must contain the %s placeholder", keycloak.AdminApiObjectIdPlaceholder)),
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "JSON object sent when creating and updating the object. Only the keys present here are checked for drift.",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressAdminApiObjectBodyDiff,
+			},
+			"ignore_fields": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Keys of the body that are never checked for drift, such as secrets or fields populated by the server. Nested keys are separated by dots.",
+			},
+			"response": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The object as last returned by the server, encoded as JSON.",
+			},
+		},
+	}
+}
+
+func getAdminApiObjectFromData(data *schema.ResourceData) (*keycloak.AdminApiObject, error) {
+	body, err := unmarshalAdminApiObjectBody(data.Get("body").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &keycloak.AdminApiObject{
+		Id:                 data.Id(),
+		CreatePath:         data.Get("create_path").(string),
+		ObjectPathTemplate: data.Get("object_path").(string),
+		Body:               body,
+	}, nil
+}
+
+func setAdminApiObjectData(data *schema.ResourceData, object *keycloak.AdminApiObject, remote map[string]interface{}) error {
+	ignoredFields := interfaceSliceToStringSlice(data.Get("ignore_fields").(*schema.Set).List())
+
+	body, err := json.Marshal(projectAdminApiObjectBody(object.Body, remote, ignoredFields, ""))
+	if err != nil {
+		return err
+	}
+
+	response, err := json.Marshal(remote)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(object.Id)
+
+	data.Set("create_path", object.CreatePath)
+	data.Set("object_path", object.ObjectPathTemplate)
+	data.Set("body", string(body))
+	data.Set("response", string(response))
+
+	return nil
+}
+
+func resourceKeycloakAdminApiObjectCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	object, err := getAdminApiObjectFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewAdminApiObject(ctx, object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(object.Id)
+
+	return resourceKeycloakAdminApiObjectRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminApiObjectRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	object, err := getAdminApiObjectFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote, err := keycloakClient.GetAdminApiObject(ctx, object)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	err = setAdminApiObjectData(data, object, remote)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakAdminApiObjectUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	object, err := getAdminApiObjectFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateAdminApiObject(ctx, object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAdminApiObjectRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminApiObjectDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	object, err := getAdminApiObjectFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(keycloakClient.DeleteAdminApiObject(ctx, object))
+}
+
+var adminApiObjectIdPlaceholderRegex = regexp.MustCompile(regexp.QuoteMeta(keycloak.AdminApiObjectIdPlaceholder))
+
+func unmarshalAdminApiObjectBody(body string) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+
+	if body == "" {
+		return result, nil
+	}
+
+	err := json.Unmarshal([]byte(body), &result)
+	if err != nil {
+		return nil, fmt.Errorf("body must be a JSON object: %s", err)
+	}
+
+	return result, nil
+}
+
+// Builds a copy of the remote object that only contains the keys present in the configured body, so that fields
+// populated by the server never show up as drift. Ignored fields always keep their configured value.
+func projectAdminApiObjectBody(configured, remote map[string]interface{}, ignoredFields []string, prefix string) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	for key, configuredValue := range configured {
+		path := prefix + key
+
+		if stringSliceContains(ignoredFields, path) {
+			result[key] = configuredValue
+			continue
+		}
+
+		remoteValue, ok := remote[key]
+		if !ok {
+			continue
+		}
+
+		configuredMap, configuredIsMap := configuredValue.(map[string]interface{})
+		remoteMap, remoteIsMap := remoteValue.(map[string]interface{})
+		if configuredIsMap && remoteIsMap {
+			result[key] = projectAdminApiObjectBody(configuredMap, remoteMap, ignoredFields, path+".")
+		} else {
+			result[key] = remoteValue
+		}
+	}
+
+	return result
+}
+
+// Two bodies are equal when they decode to the same JSON object, regardless of formatting and key order
+func suppressAdminApiObjectBodyDiff(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldBody, err := unmarshalAdminApiObjectBody(old)
+	if err != nil {
+		return false
+	}
+
+	newBody, err := unmarshalAdminApiObjectBody(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldBody, newBody)
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakAdminApiObject_basic(t *testing.T) {
+	t.Parallel()
+
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminApiObjectDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdminApiObject_clientScope(clientScopeName, "first description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminApiObjectClientScopeHasDescription("keycloak_admin_api_object.client_scope", "first description"),
+					resource.TestCheckResourceAttrSet("keycloak_admin_api_object.client_scope", "response"),
+				),
+			},
+			{
+				Config: testKeycloakAdminApiObject_clientScope(clientScopeName, "second description"),
+				Check:  testAccCheckKeycloakAdminApiObjectClientScopeHasDescription("keycloak_admin_api_object.client_scope", "second description"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdminApiObject_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var clientScope = &keycloak.OpenidClientScope{}
+
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminApiObjectDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdminApiObject_clientScope(clientScopeName, "description"),
+				Check:  testAccCheckKeycloakAdminApiObjectClientScopeFetch("keycloak_admin_api_object.client_scope", clientScope),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteOpenidClientScope(testCtx, clientScope.RealmId, clientScope.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakAdminApiObject_clientScope(clientScopeName, "description"),
+				Check:  testAccCheckKeycloakAdminApiObjectClientScopeHasDescription("keycloak_admin_api_object.client_scope", "description"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdminApiObject_driftDetection(t *testing.T) {
+	t.Parallel()
+
+	var clientScope = &keycloak.OpenidClientScope{}
+
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminApiObjectDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdminApiObject_clientScope(clientScopeName, "description"),
+				Check:  testAccCheckKeycloakAdminApiObjectClientScopeFetch("keycloak_admin_api_object.client_scope", clientScope),
+			},
+			{
+				PreConfig: func() {
+					fetchedClientScope, err := keycloakClient.GetOpenidClientScope(testCtx, clientScope.RealmId, clientScope.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					fetchedClientScope.Description = "changed outside of terraform"
+
+					err = keycloakClient.UpdateOpenidClientScope(testCtx, fetchedClientScope)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testKeycloakAdminApiObject_clientScope(clientScopeName, "description"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKeycloakAdminApiObject_clientScope(clientScopeName, "description"),
+				Check:  testAccCheckKeycloakAdminApiObjectClientScopeHasDescription("keycloak_admin_api_object.client_scope", "description"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdminApiObject_ignoreFields(t *testing.T) {
+	t.Parallel()
+
+	var clientScope = &keycloak.OpenidClientScope{}
+
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminApiObjectDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdminApiObject_clientScopeIgnoreDescription(clientScopeName),
+				Check:  testAccCheckKeycloakAdminApiObjectClientScopeFetch("keycloak_admin_api_object.client_scope", clientScope),
+			},
+			{
+				PreConfig: func() {
+					fetchedClientScope, err := keycloakClient.GetOpenidClientScope(testCtx, clientScope.RealmId, clientScope.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					fetchedClientScope.Description = "changed outside of terraform"
+
+					err = keycloakClient.UpdateOpenidClientScope(testCtx, fetchedClientScope)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testKeycloakAdminApiObject_clientScopeIgnoreDescription(clientScopeName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAdminApiObjectClientScopeHasDescription(resourceName, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clientScope, err := getAdminApiObjectClientScopeFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if clientScope.Description != description {
+			return fmt.Errorf("expected client scope %s to have description %s, but got %s", clientScope.Id, description, clientScope.Description)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAdminApiObjectClientScopeFetch(resourceName string, clientScope *keycloak.OpenidClientScope) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedClientScope, err := getAdminApiObjectClientScopeFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		clientScope.Id = fetchedClientScope.Id
+		clientScope.RealmId = fetchedClientScope.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAdminApiObjectDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_admin_api_object" {
+				continue
+			}
+
+			id := rs.Primary.ID
+
+			clientScope, _ := keycloakClient.GetOpenidClientScope(testCtx, testAccRealm.Realm, id)
+			if clientScope != nil {
+				return fmt.Errorf("admin api object %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getAdminApiObjectClientScopeFromState(s *terraform.State, resourceName string) (*keycloak.OpenidClientScope, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+
+	clientScope, err := keycloakClient.GetOpenidClientScope(testCtx, testAccRealm.Realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client scope %s: %s", id, err)
+	}
+
+	return clientScope, nil
+}
+
+func testKeycloakAdminApiObject_clientScope(clientScopeName, description string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_admin_api_object" "client_scope" {
+	create_path = "/realms/${data.keycloak_realm.realm.realm}/client-scopes"
+	object_path = "/realms/${data.keycloak_realm.realm.realm}/client-scopes/{id}"
+
+	body = jsonencode({
+		name        = "%s"
+		description = "%s"
+		protocol    = "openid-connect"
+		attributes  = {
+			"include.in.token.scope" = "true"
+		}
+	})
+}
+	`, testAccRealm.Realm, clientScopeName, description)
+}
+
+func testKeycloakAdminApiObject_clientScopeIgnoreDescription(clientScopeName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_admin_api_object" "client_scope" {
+	create_path = "/realms/${data.keycloak_realm.realm.realm}/client-scopes"
+	object_path = "/realms/${data.keycloak_realm.realm.realm}/client-scopes/{id}"
+
+	body = jsonencode({
+		name        = "%s"
+		description = "description"
+		protocol    = "openid-connect"
+	})
+
+	ignore_fields = ["description"]
+}
+	`, testAccRealm.Realm, clientScopeName)
+}
@@ -26,6 +26,12 @@ func TestAccKeycloakAttributeImporterIdentityProviderMapper_basic(t *testing.T)
 				Config: testKeycloakAttributeImporterIdentityProviderMapper_basic(alias, mapperName, userAttribute, claimName),
 				Check:  testAccCheckKeycloakAttributeImporterIdentityProviderMapperExists("keycloak_attribute_importer_identity_provider_mapper.oidc"),
 			},
+			{
+				ResourceName:      "keycloak_attribute_importer_identity_provider_mapper.oidc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/%s/%s", testAccRealm.Realm, alias, mapperName),
+			},
 		},
 	})
 }
@@ -13,6 +13,9 @@ func resourceKeycloakAuthenticationBindings() *schema.Resource {
 		ReadContext:   resourceKeycloakAuthenticationBindingsRead,
 		DeleteContext: resourceKeycloakAuthenticationBindingsDelete,
 		UpdateContext: resourceKeycloakAuthenticationBindingsUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -81,6 +84,10 @@ func resetAuthenticationBindingsForRealm(realm *keycloak.Realm) {
 func resourceKeycloakAuthenticationBindingsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := realmMutexKey(data.Get("realm_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
 	if err != nil {
 		return diag.FromErr(err)
@@ -124,6 +131,10 @@ func resourceKeycloakAuthenticationBindingsRead(ctx context.Context, data *schem
 func resourceKeycloakAuthenticationBindingsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := realmMutexKey(data.Get("realm_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realm, err := keycloakClient.GetRealm(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -142,6 +153,10 @@ func resourceKeycloakAuthenticationBindingsDelete(ctx context.Context, data *sch
 func resourceKeycloakAuthenticationBindingsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := realmMutexKey(data.Get("realm_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realm, err := keycloakClient.GetRealm(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
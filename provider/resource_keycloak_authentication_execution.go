@@ -21,6 +21,7 @@ func resourceKeycloakAuthenticationExecution() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakAuthenticationExecutionImport,
 		},
+		CustomizeDiff: customizeDiffValidateServerInfo("authenticator", validateProviderInstalled("authenticator", "authenticator", "client-authenticator", "form-action", "form-authenticator")),
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -78,6 +79,10 @@ func mapFromAuthenticationExecutionInfoToData(data *schema.ResourceData, authent
 func resourceKeycloakAuthenticationExecutionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := authenticationFlowMutexKey(data.Get("realm_id").(string), data.Get("parent_flow_alias").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	authenticationExecution := mapFromDataToAuthenticationExecution(data)
 
 	err := keycloakClient.NewAuthenticationExecution(ctx, authenticationExecution)
@@ -110,6 +115,10 @@ func resourceKeycloakAuthenticationExecutionRead(ctx context.Context, data *sche
 func resourceKeycloakAuthenticationExecutionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := authenticationFlowMutexKey(data.Get("realm_id").(string), data.Get("parent_flow_alias").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	authenticationExecution := mapFromDataToAuthenticationExecution(data)
 
 	err := keycloakClient.UpdateAuthenticationExecution(ctx, authenticationExecution)
@@ -125,6 +134,10 @@ func resourceKeycloakAuthenticationExecutionUpdate(ctx context.Context, data *sc
 func resourceKeycloakAuthenticationExecutionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := authenticationFlowMutexKey(data.Get("realm_id").(string), data.Get("parent_flow_alias").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -99,6 +100,25 @@ func TestAccKeycloakAuthenticationExecution_updateAuthenticationExecutionRequire
 	})
 }
 
+func TestAccKeycloakAuthenticationExecution_invalidAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	parentAuthFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAuthenticationExecution_withAuthenticator(parentAuthFlowAlias, "auth-cookie-does-not-exist"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`validation error: authenticator "auth-cookie-does-not-exist" does not exist on the server, installed providers: .+`),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakAuthenticationExecutionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getAuthenticationExecutionFromState(s, resourceName)
@@ -218,3 +238,22 @@ resource "keycloak_authentication_execution" "execution" {
 }
 	`, testAccRealm.Realm, parentAlias, requirement)
 }
+
+func testKeycloakAuthenticationExecution_withAuthenticator(parentAlias, authenticator string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "%s"
+}
+	`, testAccRealm.Realm, parentAlias, authenticator)
+}
@@ -92,6 +92,10 @@ func mapFromAuthenticationSubFlowToData(data *schema.ResourceData, authenticatio
 func resourceKeycloakAuthenticationSubFlowCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := authenticationFlowMutexKey(data.Get("realm_id").(string), data.Get("parent_flow_alias").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	authenticationFlow := mapFromDataToAuthenticationSubFlow(data)
 
 	err := keycloakClient.NewAuthenticationSubFlow(ctx, authenticationFlow)
@@ -120,6 +124,10 @@ func resourceKeycloakAuthenticationSubFlowRead(ctx context.Context, data *schema
 func resourceKeycloakAuthenticationSubFlowUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := authenticationFlowMutexKey(data.Get("realm_id").(string), data.Get("parent_flow_alias").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	authenticationFlow := mapFromDataToAuthenticationSubFlow(data)
 
 	err := keycloakClient.UpdateAuthenticationSubFlow(ctx, authenticationFlow)
@@ -133,6 +141,10 @@ func resourceKeycloakAuthenticationSubFlowUpdate(ctx context.Context, data *sche
 func resourceKeycloakAuthenticationSubFlowDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := authenticationFlowMutexKey(data.Get("realm_id").(string), data.Get("parent_flow_alias").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	parentFlowAlias := data.Get("parent_flow_alias").(string)
 	id := data.Id()
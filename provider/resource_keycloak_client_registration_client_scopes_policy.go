@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakClientRegistrationClientScopesPolicy() *schema.Resource {
+	return resourceKeycloakClientRegistrationPolicy("allowed-client-templates", map[string]*schema.Schema{
+		"allowed_client_scopes": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "The names of the client scopes that registered clients are allowed to use.",
+		},
+		"allow_default_scopes": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "If true, registered clients are also allowed to use the default client scopes of the realm.",
+		},
+	}, getClientRegistrationClientScopesPolicyConfig, setClientRegistrationClientScopesPolicyConfig)
+}
+
+func getClientRegistrationClientScopesPolicyConfig(data *schema.ResourceData) map[string][]string {
+	return map[string][]string{
+		"allowed-client-scopes": interfaceSliceToStringSlice(data.Get("allowed_client_scopes").(*schema.Set).List()),
+		"allow-default-scopes":  {strconv.FormatBool(data.Get("allow_default_scopes").(bool))},
+	}
+}
+
+func setClientRegistrationClientScopesPolicyConfig(data *schema.ResourceData, config map[string][]string) error {
+	allowDefaultScopes, err := getClientRegistrationPolicyBoolConfig(config, "allow-default-scopes")
+	if err != nil {
+		return err
+	}
+
+	data.Set("allowed_client_scopes", config["allowed-client-scopes"])
+	data.Set("allow_default_scopes", allowDefaultScopes)
+
+	return nil
+}
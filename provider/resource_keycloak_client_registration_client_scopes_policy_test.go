@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakClientRegistrationClientScopesPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_registration_client_scopes_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_client_scopes_policy", "allowed-client-templates"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationClientScopesPolicy_basic(policyName, clientScopeName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyExists(resourceName, "allowed-client-templates"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_client_scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allow_default_scopes", "true"),
+				),
+			},
+			{
+				Config: testKeycloakClientRegistrationClientScopesPolicy_basic(policyName, clientScopeName, false),
+				Check:  resource.TestCheckResourceAttr(resourceName, "allow_default_scopes", "false"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+		},
+	})
+}
+
+func testKeycloakClientRegistrationClientScopesPolicy_basic(name, clientScope string, allowDefaultScopes bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_client_registration_client_scopes_policy" "policy" {
+	realm_id              = data.keycloak_realm.realm.id
+	name                  = "%s"
+	sub_type              = "authenticated"
+	allowed_client_scopes = [keycloak_openid_client_scope.client_scope.name]
+	allow_default_scopes  = %t
+}
+	`, testAccRealm.Realm, clientScope, name, allowDefaultScopes)
+}
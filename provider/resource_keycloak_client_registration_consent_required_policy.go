@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The consent-required policy has no configuration, it makes all registered clients require user consent.
+func resourceKeycloakClientRegistrationConsentRequiredPolicy() *schema.Resource {
+	return resourceKeycloakClientRegistrationPolicy("consent-required", map[string]*schema.Schema{}, getClientRegistrationConsentRequiredPolicyConfig, setClientRegistrationConsentRequiredPolicyConfig)
+}
+
+func getClientRegistrationConsentRequiredPolicyConfig(_ *schema.ResourceData) map[string][]string {
+	return map[string][]string{}
+}
+
+func setClientRegistrationConsentRequiredPolicyConfig(_ *schema.ResourceData, _ map[string][]string) error {
+	return nil
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakClientRegistrationConsentRequiredPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	updatedPolicyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_registration_consent_required_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_consent_required_policy", "consent-required"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationConsentRequiredPolicy_basic(policyName, "authenticated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyExists(resourceName, "consent-required"),
+					resource.TestCheckResourceAttr(resourceName, "sub_type", "authenticated"),
+				),
+			},
+			{
+				Config: testKeycloakClientRegistrationConsentRequiredPolicy_basic(updatedPolicyName, "authenticated"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "name", updatedPolicyName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientRegistrationConsentRequiredPolicy_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var policy = &keycloak.ClientRegistrationPolicy{}
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_registration_consent_required_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_consent_required_policy", "consent-required"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationConsentRequiredPolicy_basic(policyName, "anonymous"),
+				Check:  testAccCheckKeycloakClientRegistrationPolicyFetch(resourceName, "consent-required", policy),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteClientRegistrationPolicy(testCtx, policy.RealmId, policy.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakClientRegistrationConsentRequiredPolicy_basic(policyName, "anonymous"),
+				Check:  testAccCheckKeycloakClientRegistrationPolicyExists(resourceName, "consent-required"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientRegistrationConsentRequiredPolicy_subTypeValidation(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_consent_required_policy", "consent-required"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakClientRegistrationConsentRequiredPolicy_basic(policyName, "public"),
+				ExpectError: regexp.MustCompile("expected sub_type to be one of"),
+			},
+		},
+	})
+}
+
+func testKeycloakClientRegistrationConsentRequiredPolicy_basic(name, subType string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_consent_required_policy" "policy" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+	sub_type = "%s"
+}
+	`, testAccRealm.Realm, name, subType)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKeycloakClientRegistrationMaxClientsPolicy() *schema.Resource {
+	return resourceKeycloakClientRegistrationPolicy("max-clients", map[string]*schema.Schema{
+		"max_clients": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      200,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of clients in the realm. Registration requests are rejected once the realm has this many clients.",
+		},
+	}, getClientRegistrationMaxClientsPolicyConfig, setClientRegistrationMaxClientsPolicyConfig)
+}
+
+func getClientRegistrationMaxClientsPolicyConfig(data *schema.ResourceData) map[string][]string {
+	return map[string][]string{
+		"max-clients": {strconv.Itoa(data.Get("max_clients").(int))},
+	}
+}
+
+func setClientRegistrationMaxClientsPolicyConfig(data *schema.ResourceData, config map[string][]string) error {
+	if len(config["max-clients"]) == 0 {
+		return nil
+	}
+
+	maxClients, err := strconv.Atoi(config["max-clients"][0])
+	if err != nil {
+		return err
+	}
+
+	data.Set("max_clients", maxClients)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakClientRegistrationMaxClientsPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_registration_max_clients_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_max_clients_policy", "max-clients"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationMaxClientsPolicy_basic(policyName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyExists(resourceName, "max-clients"),
+					resource.TestCheckResourceAttr(resourceName, "max_clients", "50"),
+				),
+			},
+			{
+				Config: testKeycloakClientRegistrationMaxClientsPolicy_basic(policyName, 100),
+				Check:  resource.TestCheckResourceAttr(resourceName, "max_clients", "100"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientRegistrationMaxClientsPolicy_validation(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_max_clients_policy", "max-clients"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakClientRegistrationMaxClientsPolicy_basic(policyName, 0),
+				ExpectError: regexp.MustCompile("expected max_clients to be at least"),
+			},
+		},
+	})
+}
+
+func testKeycloakClientRegistrationMaxClientsPolicy_basic(name string, maxClients int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_max_clients_policy" "policy" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s"
+	sub_type    = "authenticated"
+	max_clients = %d
+}
+	`, testAccRealm.Realm, name, maxClients)
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakClientRegistrationProtocolMappersPolicy() *schema.Resource {
+	resource := resourceKeycloakClientRegistrationPolicy("allowed-protocol-mappers", map[string]*schema.Schema{
+		"allowed_protocol_mapper_types": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Required:    true,
+			Description: "The types of protocol mappers that registered clients are allowed to use, such as oidc-usermodel-property-mapper.",
+		},
+	}, getClientRegistrationProtocolMappersPolicyConfig, setClientRegistrationProtocolMappersPolicyConfig)
+
+	resource.CustomizeDiff = customizeDiffValidateServerInfo("allowed_protocol_mapper_types", validateProviderInstalled("protocol mapper", "protocol-mapper"))
+
+	return resource
+}
+
+func getClientRegistrationProtocolMappersPolicyConfig(data *schema.ResourceData) map[string][]string {
+	return map[string][]string{
+		"allowed-protocol-mapper-types": interfaceSliceToStringSlice(data.Get("allowed_protocol_mapper_types").(*schema.Set).List()),
+	}
+}
+
+func setClientRegistrationProtocolMappersPolicyConfig(data *schema.ResourceData, config map[string][]string) error {
+	data.Set("allowed_protocol_mapper_types", config["allowed-protocol-mapper-types"])
+
+	return nil
+}
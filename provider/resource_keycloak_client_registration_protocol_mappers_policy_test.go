@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakClientRegistrationProtocolMappersPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_registration_protocol_mappers_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_protocol_mappers_policy", "allowed-protocol-mappers"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationProtocolMappersPolicy_basic(policyName, `["oidc-usermodel-property-mapper"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyExists(resourceName, "allowed-protocol-mappers"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_protocol_mapper_types.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakClientRegistrationProtocolMappersPolicy_basic(policyName, `["oidc-usermodel-property-mapper", "oidc-full-name-mapper"]`),
+				Check:  resource.TestCheckResourceAttr(resourceName, "allowed_protocol_mapper_types.#", "2"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientRegistrationProtocolMappersPolicy_unknownMapperType(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_protocol_mappers_policy", "allowed-protocol-mappers"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakClientRegistrationProtocolMappersPolicy_basic(policyName, `["oidc-does-not-exist-mapper"]`),
+				ExpectError: regexp.MustCompile(`protocol mapper "oidc-does-not-exist-mapper" does not exist on the server`),
+			},
+		},
+	})
+}
+
+func testKeycloakClientRegistrationProtocolMappersPolicy_basic(name, mapperTypes string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_protocol_mappers_policy" "policy" {
+	realm_id                      = data.keycloak_realm.realm.id
+	name                          = "%s"
+	sub_type                      = "anonymous"
+	allowed_protocol_mapper_types = %s
+}
+	`, testAccRealm.Realm, name, mapperTypes)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakClientRegistrationTrustedHostsPolicy() *schema.Resource {
+	return resourceKeycloakClientRegistrationPolicy("trusted-hosts", map[string]*schema.Schema{
+		"trusted_hosts": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "The hosts or domains that are trusted to register clients and to be used as client URIs.",
+		},
+		"host_sending_registration_request_must_match": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "If true, registration requests are only accepted from trusted hosts.",
+		},
+		"client_uris_must_match": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "If true, the URIs of registered clients, such as redirect URIs, must match a trusted host.",
+		},
+	}, getClientRegistrationTrustedHostsPolicyConfig, setClientRegistrationTrustedHostsPolicyConfig)
+}
+
+func getClientRegistrationTrustedHostsPolicyConfig(data *schema.ResourceData) map[string][]string {
+	return map[string][]string{
+		"trusted-hosts": interfaceSliceToStringSlice(data.Get("trusted_hosts").(*schema.Set).List()),
+		"host-sending-registration-request-must-match": {strconv.FormatBool(data.Get("host_sending_registration_request_must_match").(bool))},
+		"client-uris-must-match":                       {strconv.FormatBool(data.Get("client_uris_must_match").(bool))},
+	}
+}
+
+func setClientRegistrationTrustedHostsPolicyConfig(data *schema.ResourceData, config map[string][]string) error {
+	hostSendingRegistrationRequestMustMatch, err := getClientRegistrationPolicyBoolConfig(config, "host-sending-registration-request-must-match")
+	if err != nil {
+		return err
+	}
+
+	clientUrisMustMatch, err := getClientRegistrationPolicyBoolConfig(config, "client-uris-must-match")
+	if err != nil {
+		return err
+	}
+
+	data.Set("trusted_hosts", config["trusted-hosts"])
+	data.Set("host_sending_registration_request_must_match", hostSendingRegistrationRequestMustMatch)
+	data.Set("client_uris_must_match", clientUrisMustMatch)
+
+	return nil
+}
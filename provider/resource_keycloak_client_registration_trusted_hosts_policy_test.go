@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakClientRegistrationTrustedHostsPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_registration_trusted_hosts_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy("keycloak_client_registration_trusted_hosts_policy", "trusted-hosts"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationTrustedHostsPolicy_basic(policyName, `["partner.example.com"]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyExists(resourceName, "trusted-hosts"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_hosts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "host_sending_registration_request_must_match", "true"),
+					resource.TestCheckResourceAttr(resourceName, "client_uris_must_match", "true"),
+				),
+			},
+			{
+				Config: testKeycloakClientRegistrationTrustedHostsPolicy_basic(policyName, `["partner.example.com", "10.0.0.1"]`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "trusted_hosts.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "client_uris_must_match", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+		},
+	})
+}
+
+func testKeycloakClientRegistrationTrustedHostsPolicy_basic(name, trustedHosts string, clientUrisMustMatch bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_trusted_hosts_policy" "policy" {
+	realm_id               = data.keycloak_realm.realm.id
+	name                   = "%s"
+	sub_type               = "anonymous"
+	trusted_hosts          = %s
+	client_uris_must_match = %t
+}
+	`, testAccRealm.Realm, name, trustedHosts, clientUrisMustMatch)
+}
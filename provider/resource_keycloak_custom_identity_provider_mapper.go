@@ -17,6 +17,7 @@ func resourceKeycloakCustomIdentityProviderMapper() *schema.Resource {
 			// we can use the generic identity provider import func here
 			StateContext: resourceKeycloakIdentityProviderMapperImport,
 		},
+		CustomizeDiff: customizeDiffValidateServerInfo("identity_provider_mapper", validateProviderInstalled("identity provider mapper", "identity-provider-mapper")),
 		Schema: map[string]*schema.Schema{
 			"realm": {
 				Type:        schema.TypeString,
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,9 +22,14 @@ func resourceKeycloakCustomUserFederation() *schema.Resource {
 		DeleteContext: resourceKeycloakCustomUserFederationDelete,
 		// This resource can be imported using {{realm}}/{{provider_id}}. The Provider ID is displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceKeycloakCustomUserFederationImport,
+			StateContext: importWithDeletionProtection(resourceKeycloakCustomUserFederationImport),
 		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffValidateServerInfo("provider_id", validateComponentTypeInstalled("custom user federation provider", "org.keycloak.storage.UserStorageProvider")),
+			customizeDiffDeletionProtection,
+		),
 		Schema: map[string]*schema.Schema{
+			"deletion_protection": deletionProtectionSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -223,6 +229,10 @@ func resourceKeycloakCustomUserFederationUpdate(ctx context.Context, data *schem
 }
 
 func resourceKeycloakCustomUserFederationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(data, "custom user federation provider"); diags.HasError() {
+		return diags
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -23,6 +23,7 @@ func resourceKeycloakGenericClientProtocolMapper() *schema.Resource {
 			StateContext: genericProtocolMapperImport,
 		},
 		DeprecationMessage: "please use keycloak_generic_protocol_mapper instead",
+		CustomizeDiff:      customizeDiffValidateServerInfo("protocol_mapper", validateProviderInstalled("protocol mapper", "protocol-mapper")),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -22,6 +22,7 @@ func resourceKeycloakGenericProtocolMapper() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: genericProtocolMapperImport,
 		},
+		CustomizeDiff: customizeDiffValidateServerInfo("protocol_mapper", validateProviderInstalled("protocol mapper", "protocol-mapper")),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
@@ -113,6 +114,26 @@ func TestAccKeycloakGenericProtocolMapper_update(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakGenericProtocolMapper_invalidProtocolMapper(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakGenericProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakGenericProtocolMapper_invalidProtocolMapper(clientId, mapperName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`validation error: protocol mapper "saml-hardcode-attribute-mapper-does-not-exist" does not exist on the server, installed providers: .+`),
+			},
+		},
+	})
+}
+
 func testAccKeycloakGenericProtocolMapperDestroy() resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		for resourceName, rs := range state.RootModule().Resources {
@@ -171,6 +192,29 @@ resource "keycloak_generic_protocol_mapper" "client_protocol_mapper" {
 }`, testAccRealm.Realm, clientId, mapperName, mapperName)
 }
 
+func testKeycloakGenericProtocolMapper_invalidProtocolMapper(clientId string, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_generic_protocol_mapper" "client_protocol_mapper" {
+	client_id       = keycloak_saml_client.saml_client.id
+	name            = "%s"
+	protocol        = "saml"
+	protocol_mapper = "saml-hardcode-attribute-mapper-does-not-exist"
+	realm_id        = data.keycloak_realm.realm.id
+	config = {
+		"attribute.name" = "name"
+	}
+}`, testAccRealm.Realm, clientId, mapperName)
+}
+
 func testKeycloakGenericProtocolMapper_basic_clientScope(clientScopeId string, mapperName string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -17,6 +17,7 @@ func resourceKeycloakGroup() *schema.Resource {
 		ReadContext:   resourceKeycloakGroupRead,
 		DeleteContext: resourceKeycloakGroupDelete,
 		UpdateContext: resourceKeycloakGroupUpdate,
+		CustomizeDiff: customizeDiffValidateMultivaluedAttributes,
 		// This resource can be imported using {{realm}}/{{group_id}}. The Group ID is displayed in the URL when editing it from the GUI
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakGroupImport,
@@ -44,39 +45,29 @@ func resourceKeycloakGroup() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"multivalued_attributes": multivaluedAttributesSchema(),
 		},
 	}
 }
 
 func mapFromDataToGroup(data *schema.ResourceData) *keycloak.Group {
-	attributes := map[string][]string{}
-	if v, ok := data.GetOk("attributes"); ok {
-		for key, value := range v.(map[string]interface{}) {
-			attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
-		}
-	}
-
 	group := &keycloak.Group{
 		Id:         data.Id(),
 		RealmId:    data.Get("realm_id").(string),
 		ParentId:   data.Get("parent_id").(string),
 		Name:       data.Get("name").(string),
-		Attributes: attributes,
+		Attributes: getAttributesFromData(data),
 	}
 
 	return group
 }
 
 func mapFromGroupToData(data *schema.ResourceData, group *keycloak.Group) {
-	attributes := map[string]string{}
-	for k, v := range group.Attributes {
-		attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
-	}
 	data.SetId(group.Id)
 	data.Set("realm_id", group.RealmId)
 	data.Set("name", group.Name)
 	data.Set("path", group.Path)
-	data.Set("attributes", attributes)
+	setAttributesData(data, group.Attributes)
 	if group.ParentId != "" {
 		data.Set("parent_id", group.ParentId)
 	}
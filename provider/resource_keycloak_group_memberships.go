@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
@@ -15,6 +16,9 @@ func resourceKeycloakGroupMemberships() *schema.Resource {
 		ReadContext:   resourceKeycloakGroupMembershipsRead,
 		DeleteContext: resourceKeycloakGroupMembershipsDelete,
 		UpdateContext: resourceKeycloakGroupMembershipsUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGroupMembershipsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -131,6 +135,32 @@ func resourceKeycloakGroupMembershipsDelete(ctx context.Context, data *schema.Re
 	return diag.FromErr(keycloakClient.RemoveUsersFromGroup(ctx, realmId, groupId, data.Get("members").(*schema.Set).List()))
 }
 
+func resourceKeycloakGroupMembershipsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// group paths contain slashes, so only the first slash separates the realm from the group
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{groupId}}", "{{realm}}/{{groupPath}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	groupId, err := resolveImportGroupId(ctx, keycloakClient, realmId, parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("group_id", groupId)
+	d.SetId(groupMembershipsId(realmId, groupId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func groupMembershipsId(realmId, groupId string) string {
 	return fmt.Sprintf("%s/group-memberships/%s", realmId, groupId)
 }
@@ -25,6 +25,12 @@ func TestAccKeycloakGroupMemberships_basic(t *testing.T) {
 				Config: testKeycloakGroupMemberships_basic(groupName, username),
 				Check:  testAccCheckUserBelongsToGroup("keycloak_group_memberships.group_members", username),
 			},
+			{
+				ResourceName:      "keycloak_group_memberships.group_members",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s//%s", testAccRealm.Realm, groupName),
+			},
 			{
 				// we need a separate test for destroy instead of using CheckDestroy because this resource is implicitly
 				// destroyed at the end of each test via destroying users or groups they're tied to
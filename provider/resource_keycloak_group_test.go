@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -276,6 +277,40 @@ func TestAccKeycloakGroup_unsetOptionalAttributes(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakGroup_multivaluedAttributes(t *testing.T) {
+	t.Parallel()
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroup_multivaluedAttributes(groupName, "list"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakGroupExists("keycloak_group.group"),
+					resource.TestCheckResourceAttr("keycloak_group.group", "attributes.joined", "d##e"),
+					resource.TestCheckTypeSetElemNestedAttrs("keycloak_group.group", "multivalued_attributes.*", map[string]string{
+						"name":     "list",
+						"values.#": "2",
+						"values.0": "a##b",
+						"values.1": "c",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_group.group", "multivalued_attributes.*", map[string]string{
+						"name":     "joined",
+						"values.#": "2",
+					}),
+				),
+			},
+			{
+				Config:      testKeycloakGroup_multivaluedAttributes(groupName, "joined"),
+				ExpectError: regexp.MustCompile("attribute joined cannot be set in both attributes and multivalued_attributes"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakGroupExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getGroupFromState(s, resourceName)
@@ -369,6 +404,33 @@ resource "keycloak_group" "group" {
 	`, testAccRealm.Realm, group, attributeName, attributeValue)
 }
 
+func testKeycloakGroup_multivaluedAttributes(group, multivaluedAttributeName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	attributes = {
+		joined = "d##e"
+	}
+
+	multivalued_attributes {
+		name   = "%s"
+		values = ["a##b", "c"]
+	}
+}
+
+data "keycloak_group" "group" {
+	name     = keycloak_group.group.name
+	realm_id = keycloak_group.group.realm_id
+}
+	`, testAccRealm.Realm, group, multivaluedAttributeName)
+}
+
 func testKeycloakGroup_updateRealmBefore(group string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm_1" {
@@ -89,6 +89,10 @@ func setLdapCustomMapperData(data *schema.ResourceData, ldapCustomMapper *keyclo
 func resourceKeycloakLdapCustomMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapCustomMapper := getLdapCustomMapperFromData(data)
 
 	err := keycloakClient.NewLdapCustomMapper(ctx, ldapCustomMapper)
@@ -120,6 +124,10 @@ func resourceKeycloakLdapCustomMapperRead(ctx context.Context, data *schema.Reso
 func resourceKeycloakLdapCustomMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapCustomMapper := getLdapCustomMapperFromData(data)
 
 	err := keycloakClient.UpdateLdapCustomMapper(ctx, ldapCustomMapper)
@@ -135,6 +143,10 @@ func resourceKeycloakLdapCustomMapperUpdate(ctx context.Context, data *schema.Re
 func resourceKeycloakLdapCustomMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
@@ -83,6 +83,10 @@ func setLdapFullNameMapperData(data *schema.ResourceData, ldapFullNameMapper *ke
 func resourceKeycloakLdapFullNameMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapFullNameMapper := getLdapFullNameMapperFromData(data)
 
 	err := keycloakClient.ValidateLdapFullNameMapper(ctx, ldapFullNameMapper)
@@ -119,6 +123,10 @@ func resourceKeycloakLdapFullNameMapperRead(ctx context.Context, data *schema.Re
 func resourceKeycloakLdapFullNameMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapFullNameMapper := getLdapFullNameMapperFromData(data)
 
 	err := keycloakClient.ValidateLdapFullNameMapper(ctx, ldapFullNameMapper)
@@ -139,6 +147,10 @@ func resourceKeycloakLdapFullNameMapperUpdate(ctx context.Context, data *schema.
 func resourceKeycloakLdapFullNameMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
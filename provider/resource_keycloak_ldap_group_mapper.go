@@ -205,6 +205,10 @@ func setLdapGroupMapperData(ctx context.Context, keycloakClient *keycloak.Keyclo
 func resourceKeycloakLdapGroupMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapGroupMapper, err := getLdapGroupMapperFromData(ctx, keycloakClient, data)
 	if err != nil {
 		return diag.FromErr(err)
@@ -250,6 +254,10 @@ func resourceKeycloakLdapGroupMapperRead(ctx context.Context, data *schema.Resou
 func resourceKeycloakLdapGroupMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapGroupMapper, err := getLdapGroupMapperFromData(ctx, keycloakClient, data)
 	if err != nil {
 		return diag.FromErr(err)
@@ -276,6 +284,10 @@ func resourceKeycloakLdapGroupMapperUpdate(ctx context.Context, data *schema.Res
 func resourceKeycloakLdapGroupMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
@@ -75,6 +75,10 @@ func setLdapHardcodedAttributeMapperData(data *schema.ResourceData, ldapMapper *
 func resourceKeycloakLdapHardcodedAttributeMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapMapper := getLdapHardcodedAttributeMapperFromData(data)
 
 	err := keycloakClient.NewLdapHardcodedAttributeMapper(ctx, ldapMapper)
@@ -106,6 +110,10 @@ func resourceKeycloakLdapHardcodedAttributeMapperRead(ctx context.Context, data
 func resourceKeycloakLdapHardcodedAttributeMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapMapper := getLdapHardcodedAttributeMapperFromData(data)
 
 	err := keycloakClient.UpdateLdapHardcodedAttributeMapper(ctx, ldapMapper)
@@ -121,6 +129,10 @@ func resourceKeycloakLdapHardcodedAttributeMapperUpdate(ctx context.Context, dat
 func resourceKeycloakLdapHardcodedAttributeMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
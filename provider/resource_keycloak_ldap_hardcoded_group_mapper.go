@@ -66,6 +66,10 @@ func setLdapHardcodedGroupMapperData(data *schema.ResourceData, ldapMapper *keyc
 func resourceKeycloakLdapHardcodedGroupMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapMapper := getLdapHardcodedGroupMapperFromData(data)
 
 	err := keycloakClient.ValidateLdapHardcodedGroupMapper(ctx, ldapMapper)
@@ -102,6 +106,10 @@ func resourceKeycloakLdapHardcodedGroupMapperRead(ctx context.Context, data *sch
 func resourceKeycloakLdapHardcodedGroupMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapMapper := getLdapHardcodedGroupMapperFromData(data)
 
 	err := keycloakClient.ValidateLdapHardcodedGroupMapper(ctx, ldapMapper)
@@ -122,6 +130,10 @@ func resourceKeycloakLdapHardcodedGroupMapperUpdate(ctx context.Context, data *s
 func resourceKeycloakLdapHardcodedGroupMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
@@ -66,6 +66,10 @@ func setLdapHardcodedRoleMapperData(data *schema.ResourceData, ldapMapper *keycl
 func resourceKeycloakLdapHardcodedRoleMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapMapper := getLdapHardcodedRoleMapperFromData(data)
 
 	err := keycloakClient.ValidateLdapHardcodedRoleMapper(ctx, ldapMapper)
@@ -102,6 +106,10 @@ func resourceKeycloakLdapHardcodedRoleMapperRead(ctx context.Context, data *sche
 func resourceKeycloakLdapHardcodedRoleMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapMapper := getLdapHardcodedRoleMapperFromData(data)
 
 	err := keycloakClient.ValidateLdapHardcodedRoleMapper(ctx, ldapMapper)
@@ -122,6 +130,10 @@ func resourceKeycloakLdapHardcodedRoleMapperUpdate(ctx context.Context, data *sc
 func resourceKeycloakLdapHardcodedRoleMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
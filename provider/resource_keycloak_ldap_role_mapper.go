@@ -158,6 +158,10 @@ func setLdapRoleMapperData(data *schema.ResourceData, ldapRoleMapper *keycloak.L
 func resourceKeycloakLdapRoleMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapRoleMapper := getLdapRoleMapperFromData(data)
 
 	err := keycloakClient.NewLdapRoleMapper(ctx, ldapRoleMapper)
@@ -189,6 +193,10 @@ func resourceKeycloakLdapRoleMapperRead(ctx context.Context, data *schema.Resour
 func resourceKeycloakLdapRoleMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	ldapRoleMapper := getLdapRoleMapperFromData(data)
 
 	err := keycloakClient.UpdateLdapRoleMapper(ctx, ldapRoleMapper)
@@ -204,6 +212,10 @@ func resourceKeycloakLdapRoleMapperUpdate(ctx context.Context, data *schema.Reso
 func resourceKeycloakLdapRoleMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := componentMutexKey(data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
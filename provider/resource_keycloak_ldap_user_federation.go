@@ -30,9 +30,11 @@ func resourceKeycloakLdapUserFederation() *schema.Resource {
 		// Otherwise, this resource can be imported using {{realm}}/{{provider_id}}.
 		// The Provider ID is displayed in the GUI when editing this provider
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceKeycloakLdapUserFederationImport,
+			StateContext: importWithDeletionProtection(resourceKeycloakLdapUserFederationImport),
 		},
+		CustomizeDiff: customizeDiffDeletionProtection,
 		Schema: map[string]*schema.Schema{
+			"deletion_protection": deletionProtectionSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -539,6 +541,10 @@ func resourceKeycloakLdapUserFederationUpdate(ctx context.Context, data *schema.
 }
 
 func resourceKeycloakLdapUserFederationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(data, "ldap user federation provider"); diags.HasError() {
+		return diags
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
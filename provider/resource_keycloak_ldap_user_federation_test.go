@@ -547,6 +547,31 @@ func TestAccKeycloakLdapUserFederation_bindCredential(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakLdapUserFederation_deletionProtection(t *testing.T) {
+	t.Parallel()
+	ldapName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapUserFederation_deletionProtection(ldapName, true),
+				Check:  testAccCheckKeycloakLdapUserFederationExists("keycloak_ldap_user_federation.openldap"),
+			},
+			{
+				Config:      testKeycloakLdapUserFederation_withoutLdap(),
+				ExpectError: regexp.MustCompile("ldap user federation provider is protected from deletion"),
+			},
+			{
+				Config: testKeycloakLdapUserFederation_deletionProtection(ldapName, false),
+				Check:  testAccCheckKeycloakLdapUserFederationExists("keycloak_ldap_user_federation.openldap"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakLdapUserFederationExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getLdapUserFederationFromState(s, resourceName)
@@ -947,3 +972,38 @@ resource "keycloak_ldap_user_federation" "openldap" {
 }
 	`, testAccRealmUserFederation.Realm, ldap)
 }
+
+func testKeycloakLdapUserFederation_deletionProtection(ldap string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "%s"
+	realm_id                = data.keycloak_realm.realm.id
+
+	username_ldap_attribute = "cn"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"simpleSecurityObject",
+		"organizationalRole"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "admin"
+
+	deletion_protection     = %t
+}
+	`, testAccRealmUserFederation.Realm, ldap, deletionProtection)
+}
+
+func testKeycloakLdapUserFederation_withoutLdap() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+	`, testAccRealmUserFederation.Realm)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// Keycloak imports a user from a user federation provider when the user is found by a search. This resource searches
+// for the user by username so that it is imported before it logs in for the first time.
+func resourceKeycloakLdapUserImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakLdapUserImportCreate,
+		ReadContext:   resourceKeycloakLdapUserImportRead,
+		DeleteContext: resourceKeycloakLdapUserImportDelete,
+		// This resource can be imported using {{realm}}/{{userId}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakLdapUserImportImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ldap_user_federation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// Keycloak stores usernames in lowercase
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func setLdapUserImportData(data *schema.ResourceData, user *keycloak.User) {
+	data.SetId(user.Id)
+	data.Set("realm_id", user.RealmId)
+	data.Set("ldap_user_federation_id", user.FederationLink)
+	data.Set("username", user.Username)
+	data.Set("email", user.Email)
+	data.Set("first_name", user.FirstName)
+	data.Set("last_name", user.LastName)
+}
+
+func resourceKeycloakLdapUserImportCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	ldapUserFederationId := data.Get("ldap_user_federation_id").(string)
+	username := data.Get("username").(string)
+
+	_, err := keycloakClient.GetLdapUserFederation(ctx, realmId, ldapUserFederationId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users, err := keycloakClient.SearchUsers(ctx, realmId, &keycloak.UserQuery{
+		Username: username,
+		Exact:    true,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var user *keycloak.User
+	for _, u := range users {
+		if strings.EqualFold(u.Username, username) {
+			user = u
+			break
+		}
+	}
+
+	if user == nil {
+		return diag.Errorf("user with username %s was not found in realm %s", username, realmId)
+	}
+
+	if user.FederationLink != ldapUserFederationId {
+		return diag.Errorf("user with username %s is not linked to ldap user federation %s", username, ldapUserFederationId)
+	}
+
+	setLdapUserImportData(data, user)
+
+	return resourceKeycloakLdapUserImportRead(ctx, data, meta)
+}
+
+func resourceKeycloakLdapUserImportRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	user, err := keycloakClient.GetUser(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setLdapUserImportData(data, user)
+
+	return nil
+}
+
+// Deleting a user that is linked to a writable LDAP user federation deletes the user in LDAP as well, so the user is
+// only removed from the state.
+func resourceKeycloakLdapUserImportDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceKeycloakLdapUserImportImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{userId}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := keycloakClient.GetUser(ctx, realmId, parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if user.FederationLink == "" {
+		return nil, fmt.Errorf("user with id %s is not linked to a user federation provider", parts[1])
+	}
+
+	setLdapUserImportData(d, user)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakLdapUserImport_basic(t *testing.T) {
+	t.Parallel()
+
+	ldapName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapUserImport_basic(ldapName, "user01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakLdapUserImportExists("keycloak_ldap_user_import.user"),
+					resource.TestCheckResourceAttrPair("keycloak_ldap_user_import.user", "ldap_user_federation_id", "keycloak_ldap_user_federation.openldap", "id"),
+					resource.TestCheckResourceAttr("keycloak_ldap_user_import.user", "username", "user01"),
+					resource.TestCheckResourceAttrPair("data.keycloak_user.user", "federation_link", "keycloak_ldap_user_federation.openldap", "id"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_ldap_user_import.user",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealmUserFederation.Realm + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakLdapUserImport_userNotFound(t *testing.T) {
+	t.Parallel()
+
+	ldapName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakLdapUserImport_basic(ldapName, username),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("user with username %s was not found", username)),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakLdapUserImportExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		user, err := keycloakClient.GetUser(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting imported user with id %s: %s", rs.Primary.ID, err)
+		}
+
+		if user.FederationLink != rs.Primary.Attributes["ldap_user_federation_id"] {
+			return fmt.Errorf("expected user %s to be linked to user federation %s, but was linked to %s", user.Username, rs.Primary.Attributes["ldap_user_federation_id"], user.FederationLink)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakLdapUserImport_basic(ldap, username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "%s"
+	realm_id                = data.keycloak_realm.realm.id
+
+	enabled                 = true
+
+	username_ldap_attribute = "uid"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"inetOrgPerson"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "ou=users,dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "admin"
+}
+
+resource "keycloak_ldap_user_import" "user" {
+	realm_id                = data.keycloak_realm.realm.id
+	ldap_user_federation_id = keycloak_ldap_user_federation.openldap.id
+	username                = "%s"
+}
+
+data "keycloak_user" "user" {
+	realm_id = keycloak_ldap_user_import.user.realm_id
+	username = keycloak_ldap_user_import.user.username
+}
+	`, testAccRealmUserFederation.Realm, ldap, username)
+}
@@ -22,6 +22,7 @@ var (
 	keycloakOpenidClientAuthorizationPolicyEnforcementMode   = []string{"ENFORCING", "PERMISSIVE", "DISABLED"}
 	keycloakOpenidClientResourcePermissionDecisionStrategies = []string{"UNANIMOUS", "AFFIRMATIVE", "CONSENSUS"}
 	keycloakOpenidClientPkceCodeChallengeMethod              = []string{"", "plain", "S256"}
+	keycloakOpenidClientCibaSigningAlgorithms                = []string{"", "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
 )
 
 func resourceKeycloakOpenidClient() *schema.Resource {
@@ -32,7 +33,7 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 		UpdateContext: resourceKeycloakOpenidClientUpdate,
 		// This resource can be imported using {{realm}}/{{client_id}}. The Client ID is displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceKeycloakOpenidClientImport,
+			StateContext: importWithDeletionProtection(resourceKeycloakOpenidClientImport),
 		},
 		Schema: map[string]*schema.Schema{
 			"client_id": {
@@ -296,16 +297,45 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"pushed_authorization_requests_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the client must use pushed authorization requests (PAR) to start the authorization code flow.",
+			},
+			"dpop_bound_access_tokens": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, access and refresh tokens of the client are bound to a DPoP proof key. Requires the dpop feature.",
+			},
+			"ciba_grant_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables support for the OpenID Connect Client Initiated Backchannel Authentication (CIBA) grant.",
+			},
+			"ciba_backchannel_auth_request_signing_alg": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientCibaSigningAlgorithms, false),
+				Description:  "The algorithm the client uses to sign signed CIBA authentication requests.",
+			},
 			"import": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 				ForceNew: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
-		CustomizeDiff: customdiff.ComputedIf("service_account_user_id", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-			return d.HasChange("service_accounts_enabled")
-		}),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("service_account_user_id", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("service_accounts_enabled")
+			}),
+			customizeDiffValidateServerInfo("login_theme", validateThemeInstalled("login")),
+			customizeDiffDeletionProtection,
+		),
 	}
 }
 
@@ -376,6 +406,10 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 			ConsentScreenText:                     data.Get("consent_screen_text").(string),
 			DisplayOnConsentScreen:                types.KeycloakBoolQuoted(data.Get("display_on_consent_screen").(bool)),
 			PostLogoutRedirectUris:                types.KeycloakSliceHashDelimited(validPostLogoutRedirectUris),
+			RequirePushedAuthorizationRequests:    types.KeycloakBoolQuoted(data.Get("pushed_authorization_requests_required").(bool)),
+			DpopBoundAccessTokens:                 types.KeycloakBoolQuoted(data.Get("dpop_bound_access_tokens").(bool)),
+			CibaGrantEnabled:                      types.KeycloakBoolQuoted(data.Get("ciba_grant_enabled").(bool)),
+			CibaBackchannelAuthRequestSigningAlg:  data.Get("ciba_backchannel_auth_request_signing_alg").(string),
 		},
 		ValidRedirectUris: validRedirectUris,
 		WebOrigins:        webOrigins,
@@ -471,6 +505,10 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 	data.Set("oauth2_device_authorization_grant_enabled", client.Attributes.Oauth2DeviceAuthorizationGrantEnabled)
 	data.Set("oauth2_device_code_lifespan", client.Attributes.Oauth2DeviceCodeLifespan)
 	data.Set("oauth2_device_polling_interval", client.Attributes.Oauth2DevicePollingInterval)
+	data.Set("pushed_authorization_requests_required", client.Attributes.RequirePushedAuthorizationRequests)
+	data.Set("dpop_bound_access_tokens", client.Attributes.DpopBoundAccessTokens)
+	data.Set("ciba_grant_enabled", client.Attributes.CibaGrantEnabled)
+	data.Set("ciba_backchannel_auth_request_signing_alg", client.Attributes.CibaBackchannelAuthRequestSigningAlg)
 	data.Set("client_offline_session_idle_timeout", client.Attributes.ClientOfflineSessionIdleTimeout)
 	data.Set("client_offline_session_max_lifespan", client.Attributes.ClientOfflineSessionMaxLifespan)
 	data.Set("client_session_idle_timeout", client.Attributes.ClientSessionIdleTimeout)
@@ -605,6 +643,11 @@ func resourceKeycloakOpenidClientDelete(ctx context.Context, data *schema.Resour
 	if data.Get("import").(bool) {
 		return nil
 	}
+
+	if diags := checkDeletionProtection(data, "openid client"); diags.HasError() {
+		return diags
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
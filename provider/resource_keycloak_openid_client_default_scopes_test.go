@@ -29,6 +29,12 @@ func TestAccKeycloakOpenidClientDefaultScopes_basic(t *testing.T) {
 				Config: testKeycloakOpenidClientDefaultScopes_basic(client, clientScope),
 				Check:  testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client_default_scopes.default_scopes", clientScopes),
 			},
+			{
+				ResourceName:      "keycloak_openid_client_default_scopes.default_scopes",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/%s", testAccRealm.Realm, client),
+			},
 			// we need a separate test step for destroy instead of using CheckDestroy because this resource is implicitly
 			// destroyed at the end of each test via destroying clients
 			{
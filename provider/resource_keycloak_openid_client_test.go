@@ -776,6 +776,134 @@ func TestAccKeycloakOpenidClient_oauth2DeviceAuthorizationGrantEnabled(t *testin
 	})
 }
 
+func TestAccKeycloakOpenidClient_cibaAndPushedAuthorizationRequests(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_13); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_cibaAndPushedAuthorizationRequests(clientId, "CONFIDENTIAL", true, "PS256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientCibaAndPushedAuthorizationRequests("keycloak_openid_client.client", true, "PS256"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "ciba_grant_enabled", "true"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "pushed_authorization_requests_required", "true"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_cibaAndPushedAuthorizationRequests(clientId, "CONFIDENTIAL", false, ""),
+				Check:  testAccCheckKeycloakOpenidClientCibaAndPushedAuthorizationRequests("keycloak_openid_client.client", false, ""),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_cibaPublicClient(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_13); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_cibaAndPushedAuthorizationRequests(clientId, "PUBLIC", true, ""),
+				ExpectError: regexp.MustCompile("validation error: the CIBA grant cannot be enabled on public clients"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_cibaAndPushedAuthorizationRequests(clientId, "CONFIDENTIAL", true, "HS256"),
+				ExpectError: regexp.MustCompile("expected ciba_backchannel_auth_request_signing_alg to be one of"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_dpopBoundAccessTokens(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_23); !ok {
+		t.Skip()
+	}
+	skipIfFeatureIsNotEnabled(testCtx, t, keycloakClient, keycloak.DpopFeature)
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_dpopBoundAccessTokens(clientId, true),
+				Check:  resource.TestCheckResourceAttr("keycloak_openid_client.client", "dpop_bound_access_tokens", "true"),
+			},
+			{
+				Config: testKeycloakOpenidClient_dpopBoundAccessTokens(clientId, false),
+				Check:  resource.TestCheckResourceAttr("keycloak_openid_client.client", "dpop_bound_access_tokens", "false"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_deletionProtection(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_deletionProtection(clientId, true),
+				Check:  testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol("keycloak_openid_client.client"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_withoutClient(),
+				ExpectError: regexp.MustCompile("openid client is protected from deletion"),
+			},
+			{
+				Config: testKeycloakOpenidClient_deletionProtection(clientId, false),
+				Check:  testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol("keycloak_openid_client.client"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientCibaAndPushedAuthorizationRequests(resourceName string, enabled bool, signingAlg string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if bool(client.Attributes.CibaGrantEnabled) != enabled {
+			return fmt.Errorf("expected openid client to have ciba grant enabled set to %t, but got %t", enabled, client.Attributes.CibaGrantEnabled)
+		}
+
+		if bool(client.Attributes.RequirePushedAuthorizationRequests) != enabled {
+			return fmt.Errorf("expected openid client to have pushed authorization requests required set to %t, but got %t", enabled, client.Attributes.RequirePushedAuthorizationRequests)
+		}
+
+		if client.Attributes.CibaBackchannelAuthRequestSigningAlg != signingAlg {
+			return fmt.Errorf("expected openid client to have ciba signing algorithm %s, but got %s", signingAlg, client.Attributes.CibaBackchannelAuthRequestSigningAlg)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
@@ -1714,6 +1842,38 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, oauth2DeviceAuthorizationGrantEnabled)
 }
 
+func testKeycloakOpenidClient_cibaAndPushedAuthorizationRequests(clientId, accessType string, enabled bool, signingAlg string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                                 = "%s"
+	realm_id                                  = data.keycloak_realm.realm.id
+	access_type                               = "%s"
+	ciba_grant_enabled                        = %t
+	pushed_authorization_requests_required    = %t
+	ciba_backchannel_auth_request_signing_alg = "%s"
+}
+	`, testAccRealm.Realm, clientId, accessType, enabled, enabled, signingAlg)
+}
+
+func testKeycloakOpenidClient_dpopBoundAccessTokens(clientId string, enabled bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                = "%s"
+	realm_id                 = data.keycloak_realm.realm.id
+	access_type              = "CONFIDENTIAL"
+	dpop_bound_access_tokens = %t
+}
+	`, testAccRealm.Realm, clientId, enabled)
+}
+
 func testKeycloakOpenidClient_oauth2DeviceTimes(clientId, oauth2DeviceCodeLifespan, oauth2DevicePollingInterval string, oauth2DeviceAuthorizationGrantEnabled bool) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -1747,3 +1907,26 @@ resource "keycloak_openid_client" "client" {
 }
 	`, testAccRealm.Realm, clientId, enabled)
 }
+
+func testKeycloakOpenidClient_deletionProtection(clientId string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id           = "%s"
+	realm_id            = data.keycloak_realm.realm.id
+	access_type         = "CONFIDENTIAL"
+	deletion_protection = %t
+}
+	`, testAccRealm.Realm, clientId, deletionProtection)
+}
+
+func testKeycloakOpenidClient_withoutClient() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+	`, testAccRealm.Realm)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOrganization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOrganizationCreate,
+		ReadContext:   resourceKeycloakOrganizationRead,
+		DeleteContext: resourceKeycloakOrganizationDelete,
+		UpdateContext: resourceKeycloakOrganizationUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOrganizationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The alias of the organization, which cannot be changed once the organization is created. Defaults to the name of the organization.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL that members of the organization are redirected to after they accept an invitation or complete registration.",
+			},
+			"domain": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"verified": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func mapFromDataToOrganization(data *schema.ResourceData) *keycloak.Organization {
+	attributes := map[string][]string{}
+	if v, ok := data.GetOk("attributes"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+	}
+
+	domains := make([]keycloak.OrganizationDomain, 0)
+	for _, v := range data.Get("domain").(*schema.Set).List() {
+		domain := v.(map[string]interface{})
+
+		domains = append(domains, keycloak.OrganizationDomain{
+			Name:     domain["name"].(string),
+			Verified: domain["verified"].(bool),
+		})
+	}
+
+	return &keycloak.Organization{
+		Id:          data.Id(),
+		RealmId:     data.Get("realm_id").(string),
+		Name:        data.Get("name").(string),
+		Alias:       data.Get("alias").(string),
+		Enabled:     data.Get("enabled").(bool),
+		Description: data.Get("description").(string),
+		RedirectUrl: data.Get("redirect_url").(string),
+		Attributes:  attributes,
+		Domains:     domains,
+	}
+}
+
+func mapFromOrganizationToData(data *schema.ResourceData, organization *keycloak.Organization) {
+	attributes := map[string]string{}
+	for k, v := range organization.Attributes {
+		attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	var domains []interface{}
+	for _, domain := range organization.Domains {
+		domains = append(domains, map[string]interface{}{
+			"name":     domain.Name,
+			"verified": domain.Verified,
+		})
+	}
+
+	data.SetId(organization.Id)
+	data.Set("realm_id", organization.RealmId)
+	data.Set("name", organization.Name)
+	data.Set("alias", organization.Alias)
+	data.Set("enabled", organization.Enabled)
+	data.Set("description", organization.Description)
+	data.Set("redirect_url", organization.RedirectUrl)
+	data.Set("domain", domains)
+	data.Set("attributes", attributes)
+}
+
+func resourceKeycloakOrganizationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if diags := checkServerFeatureEnabled(ctx, keycloakClient, keycloak.OrganizationFeature, "organization"); diags.HasError() {
+		return diags
+	}
+
+	organization := mapFromDataToOrganization(data)
+
+	err := keycloakClient.NewOrganization(ctx, organization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(organization.Id)
+
+	return resourceKeycloakOrganizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	organization, err := keycloakClient.GetOrganization(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOrganizationToData(data, organization)
+
+	return nil
+}
+
+func resourceKeycloakOrganizationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	organization := mapFromDataToOrganization(data)
+
+	err := keycloakClient.UpdateOrganization(ctx, organization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOrganizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return diag.FromErr(keycloakClient.DeleteOrganization(ctx, data.Get("realm_id").(string), data.Id()))
+}
+
+func resourceKeycloakOrganizationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{organizationId}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = keycloakClient.GetOrganization(ctx, realmId, parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakOrganizationRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
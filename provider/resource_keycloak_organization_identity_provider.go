@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOrganizationIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOrganizationIdentityProviderCreate,
+		ReadContext:   resourceKeycloakOrganizationIdentityProviderRead,
+		DeleteContext: resourceKeycloakOrganizationIdentityProviderDelete,
+		UpdateContext: resourceKeycloakOrganizationIdentityProviderUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOrganizationIdentityProviderImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The alias of an existing identity provider that is linked to the organization.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "One of the domains of the organization. Users with an email address of this domain are redirected to the identity provider when redirect_when_email_matches is true.",
+			},
+			"redirect_when_email_matches": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceKeycloakOrganizationIdentityProviderCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if diags := checkServerFeatureEnabled(ctx, keycloakClient, keycloak.OrganizationFeature, "organization"); diags.HasError() {
+		return diags
+	}
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	alias := data.Get("alias").(string)
+
+	err := keycloakClient.LinkOrganizationIdentityProvider(ctx, realmId, organizationId, alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", organizationId, alias))
+
+	return resourceKeycloakOrganizationIdentityProviderUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationIdentityProviderRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	identityProvider, err := keycloakClient.GetOrganizationIdentityProvider(ctx, data.Get("realm_id").(string), data.Get("organization_id").(string), data.Get("alias").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	domain := ""
+	redirectWhenEmailMatches := false
+	if identityProvider.Config != nil {
+		domain, _ = identityProvider.Config.ExtraConfig[keycloak.OrganizationIdentityProviderDomainConfigKey].(string)
+
+		if redirect, ok := identityProvider.Config.ExtraConfig[keycloak.OrganizationIdentityProviderRedirectConfigKey].(string); ok {
+			redirectWhenEmailMatches, _ = strconv.ParseBool(redirect)
+		}
+	}
+
+	data.Set("domain", domain)
+	data.Set("redirect_when_email_matches", redirectWhenEmailMatches)
+
+	return nil
+}
+
+// The domain and redirect settings are stored in the configuration of the identity provider, so they are updated
+// through the identity provider itself.
+func resourceKeycloakOrganizationIdentityProviderUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	alias := data.Get("alias").(string)
+
+	identityProvider, err := keycloakClient.GetIdentityProvider(ctx, realmId, alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if identityProvider.Config == nil {
+		identityProvider.Config = &keycloak.IdentityProviderConfig{}
+	}
+
+	if identityProvider.Config.ExtraConfig == nil {
+		identityProvider.Config.ExtraConfig = map[string]interface{}{}
+	}
+
+	identityProvider.Config.ExtraConfig[keycloak.OrganizationIdentityProviderDomainConfigKey] = data.Get("domain").(string)
+	identityProvider.Config.ExtraConfig[keycloak.OrganizationIdentityProviderRedirectConfigKey] = strconv.FormatBool(data.Get("redirect_when_email_matches").(bool))
+
+	err = keycloakClient.UpdateIdentityProvider(ctx, identityProvider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOrganizationIdentityProviderRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationIdentityProviderDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.UnlinkOrganizationIdentityProvider(ctx, data.Get("realm_id").(string), data.Get("organization_id").(string), data.Get("alias").(string))
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakOrganizationIdentityProviderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, invalidImportError("{{realm}}/{{organizationId}}/{{identityProviderAlias}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = keycloakClient.GetOrganizationIdentityProvider(ctx, realmId, parts[1], parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("organization_id", parts[1])
+	d.Set("alias", parts[2])
+	d.SetId(fmt.Sprintf("%s/%s", parts[1], parts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOrganizationIdentityProvider_basic(t *testing.T) {
+	skipIfFeatureIsNotEnabled(testCtx, t, keycloakClient, keycloak.OrganizationFeature)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganizationIdentityProvider_basic(realmName, "Corporate", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_organization_identity_provider.identity_provider", "domain", "example.com"),
+					resource.TestCheckResourceAttr("keycloak_organization_identity_provider.identity_provider", "redirect_when_email_matches", "true"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_organization_identity_provider.identity_provider",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["keycloak_organization_identity_provider.identity_provider"]
+
+					return fmt.Sprintf("%s/%s/%s", realmName, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["alias"]), nil
+				},
+			},
+			{
+				// updating the identity provider itself keeps it linked to the organization
+				Config: testKeycloakOrganizationIdentityProvider_basic(realmName, "Corporate SSO", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "display_name", "Corporate SSO"),
+					resource.TestCheckResourceAttr("keycloak_organization_identity_provider.identity_provider", "domain", "example.com"),
+					resource.TestCheckResourceAttr("keycloak_organization_identity_provider.identity_provider", "redirect_when_email_matches", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakOrganizationIdentityProvider_basic(realm, displayName string, redirect bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = keycloak_realm.realm.id
+	name     = "tf-acc-organization"
+
+	domain {
+		name = "example.com"
+	}
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = keycloak_realm.realm.id
+	alias             = "corporate"
+	display_name      = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_organization_identity_provider" "identity_provider" {
+	realm_id                    = keycloak_realm.realm.id
+	organization_id             = keycloak_organization.organization.id
+	alias                       = keycloak_oidc_identity_provider.oidc.alias
+	domain                      = "example.com"
+	redirect_when_email_matches = %t
+}
+	`, realm, displayName, redirect)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOrganizationMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOrganizationMembershipCreate,
+		ReadContext:   resourceKeycloakOrganizationMembershipRead,
+		DeleteContext: resourceKeycloakOrganizationMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOrganizationMembershipImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"membership_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "MANAGED when the user was created through an identity provider of the organization, otherwise UNMANAGED.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakOrganizationMembershipCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if diags := checkServerFeatureEnabled(ctx, keycloakClient, keycloak.OrganizationFeature, "organization"); diags.HasError() {
+		return diags
+	}
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	userId := data.Get("user_id").(string)
+
+	err := keycloakClient.AddOrganizationMember(ctx, realmId, organizationId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s/%s", realmId, organizationId, userId))
+
+	return resourceKeycloakOrganizationMembershipRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationMembershipRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	member, err := keycloakClient.GetOrganizationMember(ctx, data.Get("realm_id").(string), data.Get("organization_id").(string), data.Get("user_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("membership_type", member.MembershipType)
+
+	return nil
+}
+
+func resourceKeycloakOrganizationMembershipDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.RemoveOrganizationMember(ctx, data.Get("realm_id").(string), data.Get("organization_id").(string), data.Get("user_id").(string))
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakOrganizationMembershipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, invalidImportError("{{realm}}/{{organizationId}}/{{userId}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = keycloakClient.GetOrganizationMember(ctx, realmId, parts[1], parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("organization_id", parts[1])
+	d.Set("user_id", parts[2])
+	d.SetId(fmt.Sprintf("%s/%s/%s", realmId, parts[1], parts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
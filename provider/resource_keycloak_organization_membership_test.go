@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOrganizationMembership_basic(t *testing.T) {
+	skipIfFeatureIsNotEnabled(testCtx, t, keycloakClient, keycloak.OrganizationFeature)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganizationMembership_basic(realmName),
+				Check:  resource.TestCheckResourceAttr("keycloak_organization_membership.membership", "membership_type", "UNMANAGED"),
+			},
+			{
+				ResourceName:      "keycloak_organization_membership.membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testKeycloakOrganizationMembership_basic(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = keycloak_realm.realm.id
+	name     = "tf-acc-organization"
+
+	domain {
+		name = "example.com"
+	}
+}
+
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "tf-acc-user"
+	email    = "tf-acc-user@example.com"
+}
+
+resource "keycloak_organization_membership" "membership" {
+	realm_id        = keycloak_realm.realm.id
+	organization_id = keycloak_organization.organization.id
+	user_id         = keycloak_user.user.id
+}
+	`, realm)
+}
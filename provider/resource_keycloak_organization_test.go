@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOrganization_basic(t *testing.T) {
+	skipIfFeatureIsNotEnabled(testCtx, t, keycloakClient, keycloak.OrganizationFeature)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganization_basic(realmName, organizationName, "First description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationExists("keycloak_organization.organization"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "alias", organizationName),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "domain.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "attributes.tier", "gold"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_organization.organization",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+			{
+				Config: testKeycloakOrganization_basic(realmName, organizationName, "Second description"),
+				Check:  resource.TestCheckResourceAttr("keycloak_organization.organization", "description", "Second description"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOrganizationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetOrganization(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testKeycloakOrganization_basic(realm, organization, description string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id     = keycloak_realm.realm.id
+	name         = "%s"
+	description  = "%s"
+	redirect_url = "https://example.com/welcome"
+
+	domain {
+		name     = "example.com"
+		verified = true
+	}
+
+	domain {
+		name = "example.org"
+	}
+
+	attributes = {
+		tier = "gold"
+	}
+}
+	`, realm, organization, description)
+}
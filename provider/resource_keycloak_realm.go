@@ -2,159 +2,53 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak/types"
+	"strconv"
 )
 
 var (
 	keycloakRealmValidOTPTypes      = []string{"totp", "hotp"}
 	keycloakRealmValidOTPAlgorithms = []string{"HmacSHA1", "HmacSHA256", "HmacSHA512"}
+
+	keycloakRealmValidCibaBackchannelTokenDeliveryModes = []string{"poll", "ping"}
+	keycloakRealmValidCibaAuthRequestedUserHints        = []string{"login_hint"}
 )
 
 func resourceKeycloakRealm() *schema.Resource {
-
-	otpPolicySchema := map[string]*schema.Schema{
-		"type": {
-			Type:         schema.TypeString,
-			Description:  "OTP Type, totp for Time-Based One Time Password or hotp for counter base one time password",
-			Optional:     true,
-			Default:      "totp",
-			ValidateFunc: validation.StringInSlice(keycloakRealmValidOTPTypes, false),
-		},
-		"algorithm": {
-			Type:         schema.TypeString,
-			Description:  "What hashing algorithm should be used to generate the OTP.",
-			Optional:     true,
-			Default:      "HmacSHA1",
-			ValidateFunc: validation.StringInSlice(keycloakRealmValidOTPAlgorithms, false),
-		},
-		"digits": {
-			Type: schema.TypeInt,
-			Elem: &schema.Schema{
-				Type: schema.TypeInt,
-			},
-			Default:  6,
-			Optional: true,
-		},
-		"initial_counter": {
-			Type: schema.TypeInt,
-			Elem: &schema.Schema{
-				Type: schema.TypeInt,
-			},
-			Default:  2,
-			Optional: true,
-		},
-		"look_ahead_window": {
-			Type: schema.TypeInt,
-			Elem: &schema.Schema{
-				Type: schema.TypeInt,
-			},
-			Default:  1,
-			Optional: true,
-		},
-		"period": {
-			Type: schema.TypeInt,
-			Elem: &schema.Schema{
-				Type: schema.TypeInt,
-			},
-			Default:  30,
-			Optional: true,
-		},
-	}
-
-	webAuthnSchema := map[string]*schema.Schema{
-		"acceptable_aaguids": {
-			Type: schema.TypeSet,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
-			},
-			Optional: true,
-		},
-		"attestation_conveyance_preference": {
-			Type:         schema.TypeString,
-			Description:  "Either none, indirect or direct",
-			Optional:     true,
-			Default:      "not specified",
-			ValidateFunc: validation.StringInSlice([]string{"not specified", "none", "indirect", "direct", "enterprise"}, false),
-		},
-		"authenticator_attachment": {
-			Type:         schema.TypeString,
-			Description:  "Either platform or cross-platform",
-			Optional:     true,
-			Default:      "not specified",
-			ValidateFunc: validation.StringInSlice([]string{"not specified", "platform", "cross-platform"}, false),
-		},
-		"avoid_same_authenticator_register": {
-			Type:     schema.TypeBool,
-			Optional: true,
-			Default:  false,
-		},
-		"create_timeout": {
-			Type:     schema.TypeInt,
-			Optional: true,
-			Default:  0,
-			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
-				v := i.(int)
-
-				// https://w3c.github.io/webauthn/#sctn-createCredential
-				if v != 0 && (v < 30 || v > 600) {
-					return []string{"the recommended timeout value is between 30<->180 seconds (inclusive, userVerification=discouraged) or 30<->600 seconds (inclusive, userVerification=(required || preferred))"}, nil
-				}
-
-				return nil, nil
-			},
-		},
-		"require_resident_key": {
-			Type:         schema.TypeString,
-			Description:  "Either Yes or No",
-			Optional:     true,
-			Default:      "not specified",
-			ValidateFunc: validation.StringInSlice([]string{"not specified", "Yes", "No"}, false),
-		},
-		"relying_party_entity_name": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Default:  "keycloak",
-		},
-		"relying_party_id": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Default:  "",
-		},
-		"signature_algorithms": {
-			Type: schema.TypeSet,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
-			},
-			Description: "Keycloak lists ES256, ES384, ES512, RS256, RS384, RS512, RS1 at the time of writing",
-			Optional:    true,
-			Computed:    true,
-		},
-		"user_verification_requirement": {
-			Type:         schema.TypeString,
-			Description:  "Either required, preferred or discouraged",
-			Optional:     true,
-			Default:      "not specified",
-			ValidateFunc: validation.StringInSlice([]string{"not specified", "required", "preferred", "discouraged"}, false),
-		},
-	}
-	return &schema.Resource{
+	realmResource := &schema.Resource{
 		CreateContext: resourceKeycloakRealmCreate,
 		ReadContext:   resourceKeycloakRealmRead,
 		DeleteContext: resourceKeycloakRealmDelete,
 		UpdateContext: resourceKeycloakRealmUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithDeletionProtection(schema.ImportStatePassthroughContext),
 		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffValidateServerInfo("login_theme", validateThemeInstalled("login")),
+			customizeDiffValidateServerInfo("account_theme", validateThemeInstalled("account")),
+			customizeDiffValidateServerInfo("admin_theme", validateThemeInstalled("admin")),
+			customizeDiffValidateServerInfo("email_theme", validateThemeInstalled("email")),
+			customizeDiffValidateServerInfoBlocks("password_policy_rule", "type", validatePasswordPolicyAvailable()),
+			customizeDiffDeletionProtection,
+			customdiff.If(func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+				return d.Get("smtp_server.0.verify_on_apply").(bool) && d.Get("smtp_server.0.verify_recipient").(string) == "" && d.HasChange("smtp_server")
+			}, func(ctx context.Context, _ *schema.ResourceDiff, meta interface{}) error {
+				return validateSmtpTestRecipient(ctx, meta)
+			}),
+		),
 		Schema: map[string]*schema.Schema{
 			"realm": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -179,6 +73,12 @@ func resourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"organizations_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "When true, organizations can be managed within the realm. Requires the organization feature to be enabled on the Keycloak server.",
+			},
 
 			// Login Config
 			"registration_allowed": {
@@ -234,65 +134,7 @@ func resourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"starttls": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"port": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"host": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"reply_to": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"reply_to_display_name": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"from": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"from_display_name": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"envelope_from": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"ssl": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"auth": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"username": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"password": {
-										Type:      schema.TypeString,
-										Required:  true,
-										Sensitive: true,
-										DiffSuppressFunc: func(_, smtpServerPassword, _ string, _ *schema.ResourceData) bool {
-											return smtpServerPassword == "**********"
-										},
-									},
-								},
-							},
-						},
-					},
+					Schema: realmSmtpServerSchema(),
 				},
 			},
 
@@ -314,128 +156,6 @@ func resourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 			},
 
-			// Tokens
-			"default_signature_algorithm": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"revoke_refresh_token": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"refresh_token_max_reuse": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  0,
-			},
-			"sso_session_idle_timeout": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"sso_session_idle_timeout_remember_me": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"sso_session_max_lifespan": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"sso_session_max_lifespan_remember_me": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"offline_session_idle_timeout": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"offline_session_max_lifespan": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"offline_session_max_lifespan_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"client_session_idle_timeout": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"client_session_max_lifespan": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"access_token_lifespan": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"access_token_lifespan_for_implicit_flow": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"access_code_lifespan": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"access_code_lifespan_login": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"access_code_lifespan_user_action": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"action_token_generated_by_user_lifespan": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"action_token_generated_by_admin_lifespan": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"oauth2_device_code_lifespan": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressDurationStringDiff,
-			},
-			"oauth2_device_polling_interval": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-
 			// internationalization
 			"internationalization": {
 				Type:     schema.TypeList,
@@ -469,48 +189,7 @@ func resourceKeycloakRealm() *schema.Resource {
 							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"x_frame_options": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "SAMEORIGIN",
-									},
-									"content_security_policy": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "frame-src 'self'; frame-ancestors 'self'; object-src 'none';",
-									},
-									"content_security_policy_report_only": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "",
-									},
-									"x_content_type_options": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "nosniff",
-									},
-									"x_robots_tag": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "none",
-									},
-									"x_xss_protection": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "1; mode=block",
-									},
-									"strict_transport_security": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "max-age=31536000; includeSubDomains",
-									},
-									"referrer_policy": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "no-referrer",
-									},
-								},
+								Schema: realmSecurityHeadersSchema(),
 							},
 						},
 						"brute_force_detection": {
@@ -518,43 +197,7 @@ func resourceKeycloakRealm() *schema.Resource {
 							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"permanent_lockout": { //Permanent Lockout
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-									"max_login_failures": { //failureFactor
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  30,
-									},
-									"wait_increment_seconds": { //Wait Increment
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  60,
-									},
-									"quick_login_check_milli_seconds": { //Quick Login Check Milli Seconds
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  1000,
-									},
-									"minimum_quick_login_wait_seconds": { //Minimum Quick Login Wait
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  60,
-									},
-									"max_failure_wait_seconds": { //Max Wait
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  900,
-									},
-									"failure_reset_time_seconds": { //maxDeltaTimeSeconds
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  43200,
-									},
-								},
+								Schema: realmBruteForceDetectionSchema(),
 							},
 						},
 					},
@@ -563,9 +206,30 @@ func resourceKeycloakRealm() *schema.Resource {
 
 			// authentication password policy
 			"password_policy": {
-				Type:        schema.TypeString,
-				Description: "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
-				Optional:    true,
+				Type:          schema.TypeString,
+				Description:   "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
+				Optional:      true,
+				ConflictsWith: []string{"password_policy_rule"},
+			},
+			"password_policy_rule": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"password_policy"},
+				Description:   "The password policies that are in place, as an alternative to password_policy. The order of the rules does not matter.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the password policy, such as length or notUsername.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The configuration of the password policy, such as 12 for length. Omitted for policies without configuration.",
+						},
+					},
+				},
 			},
 
 			// authentication flow bindings
@@ -635,7 +299,18 @@ func resourceKeycloakRealm() *schema.Resource {
 				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
-					Schema: otpPolicySchema,
+					Schema: realmOtpPolicySchema(),
+				},
+			},
+
+			// CIBA
+			"ciba_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: realmCibaPolicySchema(),
 				},
 			},
 
@@ -646,7 +321,7 @@ func resourceKeycloakRealm() *schema.Resource {
 				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
-					Schema: webAuthnSchema,
+					Schema: realmWebAuthnPolicySchema(),
 				},
 			},
 
@@ -657,178 +332,928 @@ func resourceKeycloakRealm() *schema.Resource {
 				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
-					Schema: webAuthnSchema,
+					Schema: realmWebAuthnPolicySchema(),
 				},
 			},
 		},
 	}
-}
-
-func getRealmSMTPPasswordFromData(data *schema.ResourceData) (string, bool) {
-	if v, ok := data.GetOk("smtp_server"); ok {
-		smtpSettings := v.([]interface{})[0].(map[string]interface{})
-		authConfig := smtpSettings["auth"].([]interface{})
-
-		if len(authConfig) == 1 {
-			return authConfig[0].(map[string]interface{})["password"].(string), true
-		}
 
-		return "", false
-	}
+	realmResource.Schema = mergeSchemas(realmResource.Schema, realmTokenSettingsSchema())
 
-	return "", false
+	return realmResource
 }
 
-func setRealmFlowBindings(data *schema.ResourceData, realm *keycloak.Realm) {
-	if flow, ok := data.GetOk("browser_flow"); ok {
-		realm.BrowserFlow = stringPointer(flow.(string))
-	} else {
-		realm.BrowserFlow = stringPointer("browser")
-	}
-
-	if flow, ok := data.GetOk("registration_flow"); ok {
-		realm.RegistrationFlow = stringPointer(flow.(string))
-	} else {
-		realm.RegistrationFlow = stringPointer("registration")
-	}
-
-	if flow, ok := data.GetOk("direct_grant_flow"); ok {
-		realm.DirectGrantFlow = stringPointer(flow.(string))
-	} else {
-		realm.DirectGrantFlow = stringPointer("direct grant")
-	}
-
-	if flow, ok := data.GetOk("reset_credentials_flow"); ok {
-		realm.ResetCredentialsFlow = stringPointer(flow.(string))
-	} else {
-		realm.ResetCredentialsFlow = stringPointer("reset credentials")
-	}
-
-	if flow, ok := data.GetOk("client_authentication_flow"); ok {
-		realm.ClientAuthenticationFlow = stringPointer(flow.(string))
-	} else {
-		realm.ClientAuthenticationFlow = stringPointer("clients")
-	}
-
-	if flow, ok := data.GetOk("docker_authentication_flow"); ok {
+func realmSmtpServerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"starttls": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"port": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"host": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"reply_to": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"reply_to_display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"from": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"from_display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"envelope_from": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"ssl": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"verify_on_apply": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When true, a test email is sent with the settings before they are applied.",
+		},
+		"verify_recipient": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The address the test email of verify_on_apply is sent to. Defaults to the email address of the user the provider is authenticated as.",
+		},
+		"auth": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"password": {
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
+						DiffSuppressFunc: func(_, smtpServerPassword, _ string, _ *schema.ResourceData) bool {
+							return smtpServerPassword == "**********"
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func realmTokenSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"default_signature_algorithm": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"revoke_refresh_token": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"refresh_token_max_reuse": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"sso_session_idle_timeout": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"sso_session_idle_timeout_remember_me": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"sso_session_max_lifespan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"sso_session_max_lifespan_remember_me": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"offline_session_idle_timeout": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"offline_session_max_lifespan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"offline_session_max_lifespan_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"client_session_idle_timeout": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"client_session_max_lifespan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"access_token_lifespan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"access_token_lifespan_for_implicit_flow": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"access_code_lifespan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"access_code_lifespan_login": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"access_code_lifespan_user_action": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"action_token_generated_by_user_lifespan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"action_token_generated_by_admin_lifespan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"oauth2_device_code_lifespan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		},
+		"oauth2_device_polling_interval": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func realmSecurityHeadersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"x_frame_options": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "SAMEORIGIN",
+		},
+		"content_security_policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "frame-src 'self'; frame-ancestors 'self'; object-src 'none';",
+		},
+		"content_security_policy_report_only": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"x_content_type_options": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "nosniff",
+		},
+		"x_robots_tag": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "none",
+		},
+		"x_xss_protection": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "1; mode=block",
+		},
+		"strict_transport_security": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "max-age=31536000; includeSubDomains",
+		},
+		"referrer_policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "no-referrer",
+		},
+	}
+}
+
+func realmBruteForceDetectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"permanent_lockout": { //Permanent Lockout
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"max_login_failures": { //failureFactor
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  30,
+		},
+		"wait_increment_seconds": { //Wait Increment
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  60,
+		},
+		"quick_login_check_milli_seconds": { //Quick Login Check Milli Seconds
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1000,
+		},
+		"minimum_quick_login_wait_seconds": { //Minimum Quick Login Wait
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  60,
+		},
+		"max_failure_wait_seconds": { //Max Wait
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  900,
+		},
+		"failure_reset_time_seconds": { //maxDeltaTimeSeconds
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  43200,
+		},
+	}
+}
+
+func realmCibaPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"backchannel_token_delivery_mode": {
+			Type:         schema.TypeString,
+			Description:  "How the client receives the tokens, poll or ping.",
+			Optional:     true,
+			Default:      "poll",
+			ValidateFunc: validation.StringInSlice(keycloakRealmValidCibaBackchannelTokenDeliveryModes, false),
+		},
+		"expires_in": {
+			Type:             schema.TypeString,
+			Description:      "Duration after which an authentication request expires, such as 2m.",
+			Optional:         true,
+			Default:          "2m0s",
+			DiffSuppressFunc: suppressDurationStringDiff,
+			ValidateFunc:     validateDurationString,
+		},
+		"interval": {
+			Type:         schema.TypeInt,
+			Description:  "The minimum number of seconds the client must wait between polling requests.",
+			Optional:     true,
+			Default:      5,
+			ValidateFunc: validation.IntBetween(0, 600),
+		},
+		"auth_requested_user_hint": {
+			Type:         schema.TypeString,
+			Description:  "The way the user is identified in authentication requests.",
+			Optional:     true,
+			Default:      "login_hint",
+			ValidateFunc: validation.StringInSlice(keycloakRealmValidCibaAuthRequestedUserHints, false),
+		},
+	}
+}
+
+func realmOtpPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Description:  "OTP Type, totp for Time-Based One Time Password or hotp for counter base one time password",
+			Optional:     true,
+			Default:      "totp",
+			ValidateFunc: validation.StringInSlice(keycloakRealmValidOTPTypes, false),
+		},
+		"algorithm": {
+			Type:         schema.TypeString,
+			Description:  "What hashing algorithm should be used to generate the OTP.",
+			Optional:     true,
+			Default:      "HmacSHA1",
+			ValidateFunc: validation.StringInSlice(keycloakRealmValidOTPAlgorithms, false),
+		},
+		"digits": {
+			Type: schema.TypeInt,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+			Default:  6,
+			Optional: true,
+		},
+		"initial_counter": {
+			Type: schema.TypeInt,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+			Default:  2,
+			Optional: true,
+		},
+		"look_ahead_window": {
+			Type: schema.TypeInt,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+			Default:  1,
+			Optional: true,
+		},
+		"period": {
+			Type: schema.TypeInt,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+			Default:  30,
+			Optional: true,
+		},
+	}
+}
+
+func realmWebAuthnPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"acceptable_aaguids": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+		},
+		"attestation_conveyance_preference": {
+			Type:         schema.TypeString,
+			Description:  "Either none, indirect or direct",
+			Optional:     true,
+			Default:      "not specified",
+			ValidateFunc: validation.StringInSlice([]string{"not specified", "none", "indirect", "direct", "enterprise"}, false),
+		},
+		"authenticator_attachment": {
+			Type:         schema.TypeString,
+			Description:  "Either platform or cross-platform",
+			Optional:     true,
+			Default:      "not specified",
+			ValidateFunc: validation.StringInSlice([]string{"not specified", "platform", "cross-platform"}, false),
+		},
+		"avoid_same_authenticator_register": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"create_timeout": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
+			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+				v := i.(int)
+
+				// https://w3c.github.io/webauthn/#sctn-createCredential
+				if v != 0 && (v < 30 || v > 600) {
+					return []string{"the recommended timeout value is between 30<->180 seconds (inclusive, userVerification=discouraged) or 30<->600 seconds (inclusive, userVerification=(required || preferred))"}, nil
+				}
+
+				return nil, nil
+			},
+		},
+		"require_resident_key": {
+			Type:         schema.TypeString,
+			Description:  "Either Yes or No",
+			Optional:     true,
+			Default:      "not specified",
+			ValidateFunc: validation.StringInSlice([]string{"not specified", "Yes", "No"}, false),
+		},
+		"relying_party_entity_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "keycloak",
+		},
+		"relying_party_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"signature_algorithms": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Keycloak lists ES256, ES384, ES512, RS256, RS384, RS512, RS1 at the time of writing",
+			Optional:    true,
+			Computed:    true,
+		},
+		"user_verification_requirement": {
+			Type:         schema.TypeString,
+			Description:  "Either required, preferred or discouraged",
+			Optional:     true,
+			Default:      "not specified",
+			ValidateFunc: validation.StringInSlice([]string{"not specified", "required", "preferred", "discouraged"}, false),
+		},
+	}
+}
+
+func getRealmSMTPPasswordFromData(data *schema.ResourceData) (string, bool) {
+	if v, ok := data.GetOk("smtp_server"); ok {
+		smtpSettings := v.([]interface{})[0].(map[string]interface{})
+		authConfig := smtpSettings["auth"].([]interface{})
+
+		if len(authConfig) == 1 {
+			return authConfig[0].(map[string]interface{})["password"].(string), true
+		}
+
+		return "", false
+	}
+
+	return "", false
+}
+
+func setRealmFlowBindings(data *schema.ResourceData, realm *keycloak.Realm) {
+	if flow, ok := data.GetOk("browser_flow"); ok {
+		realm.BrowserFlow = stringPointer(flow.(string))
+	} else {
+		realm.BrowserFlow = stringPointer("browser")
+	}
+
+	if flow, ok := data.GetOk("registration_flow"); ok {
+		realm.RegistrationFlow = stringPointer(flow.(string))
+	} else {
+		realm.RegistrationFlow = stringPointer("registration")
+	}
+
+	if flow, ok := data.GetOk("direct_grant_flow"); ok {
+		realm.DirectGrantFlow = stringPointer(flow.(string))
+	} else {
+		realm.DirectGrantFlow = stringPointer("direct grant")
+	}
+
+	if flow, ok := data.GetOk("reset_credentials_flow"); ok {
+		realm.ResetCredentialsFlow = stringPointer(flow.(string))
+	} else {
+		realm.ResetCredentialsFlow = stringPointer("reset credentials")
+	}
+
+	if flow, ok := data.GetOk("client_authentication_flow"); ok {
+		realm.ClientAuthenticationFlow = stringPointer(flow.(string))
+	} else {
+		realm.ClientAuthenticationFlow = stringPointer("clients")
+	}
+
+	if flow, ok := data.GetOk("docker_authentication_flow"); ok {
 		realm.DockerAuthenticationFlow = stringPointer(flow.(string))
 	} else {
-		realm.DockerAuthenticationFlow = stringPointer("docker auth")
+		realm.DockerAuthenticationFlow = stringPointer("docker auth")
+	}
+}
+
+func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
+	internationalizationEnabled := false
+	supportLocales := make([]string, 0)
+	defaultLocale := ""
+	if v, ok := data.GetOk("internationalization"); ok {
+		internationalizationEnabled = true
+		internationalizationSettings := v.([]interface{})[0].(map[string]interface{})
+		if v, ok := internationalizationSettings["supported_locales"]; ok {
+			for _, supportLocale := range v.(*schema.Set).List() {
+				supportLocales = append(supportLocales, supportLocale.(string))
+			}
+		}
+		defaultLocale = internationalizationSettings["default_locale"].(string)
+	}
+
+	realmId := data.Get("realm")
+	internalId := data.Get("internal_id")
+	if internalId != "" {
+		realmId = internalId
+	}
+
+	realm := &keycloak.Realm{
+		Id:                realmId.(string),
+		Realm:             data.Get("realm").(string),
+		Enabled:           data.Get("enabled").(bool),
+		DisplayName:       data.Get("display_name").(string),
+		DisplayNameHtml:   data.Get("display_name_html").(string),
+		UserManagedAccess: data.Get("user_managed_access").(bool),
+
+		// Login Config
+		RegistrationAllowed:         data.Get("registration_allowed").(bool),
+		RegistrationEmailAsUsername: data.Get("registration_email_as_username").(bool),
+		EditUsernameAllowed:         data.Get("edit_username_allowed").(bool),
+		ResetPasswordAllowed:        data.Get("reset_password_allowed").(bool),
+		RememberMe:                  data.Get("remember_me").(bool),
+		VerifyEmail:                 data.Get("verify_email").(bool),
+		LoginWithEmailAllowed:       data.Get("login_with_email_allowed").(bool),
+		DuplicateEmailsAllowed:      data.Get("duplicate_emails_allowed").(bool),
+		SslRequired:                 data.Get("ssl_required").(string),
+
+		//internationalization
+		InternationalizationEnabled: internationalizationEnabled,
+		SupportLocales:              supportLocales,
+		DefaultLocale:               defaultLocale,
+	}
+
+	// organizations are only supported by newer versions of Keycloak, so the setting is only sent when it is configured
+	if attributeIsConfigured(data.GetRawConfig(), "organizations_enabled") {
+		organizationsEnabled := data.Get("organizations_enabled").(bool)
+		realm.OrganizationsEnabled = &organizationsEnabled
+	}
+
+	//smtp
+	if v, ok := data.GetOk("smtp_server"); ok {
+		realm.SmtpServer = getRealmSmtpServerFromSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	// Themes
+
+	if loginTheme, ok := data.GetOk("login_theme"); ok {
+		realm.LoginTheme = loginTheme.(string)
+	}
+
+	if accountTheme, ok := data.GetOk("account_theme"); ok {
+		realm.AccountTheme = accountTheme.(string)
+	}
+
+	if adminTheme, ok := data.GetOk("admin_theme"); ok {
+		realm.AdminTheme = adminTheme.(string)
+	}
+
+	if emailTheme, ok := data.GetOk("email_theme"); ok {
+		realm.EmailTheme = emailTheme.(string)
+	}
+
+	// Tokens
+	if err := setRealmTokenSettingsFromData(data, realm); err != nil {
+		return nil, err
+	}
+
+	//security defenses
+	if v, ok := data.GetOk("security_defenses"); ok {
+		securityDefensesSettings := v.([]interface{})[0].(map[string]interface{})
+
+		headersConfig := securityDefensesSettings["headers"].([]interface{})
+		if len(headersConfig) == 1 {
+			realm.BrowserSecurityHeaders = getBrowserSecurityHeadersFromSettings(headersConfig[0].(map[string]interface{}))
+		} else {
+			setDefaultSecuritySettingHeaders(realm)
+		}
+
+		bruteForceDetectionConfig := securityDefensesSettings["brute_force_detection"].([]interface{})
+		if len(bruteForceDetectionConfig) == 1 {
+			setRealmBruteForceDetectionFromSettings(realm, bruteForceDetectionConfig[0].(map[string]interface{}))
+		} else {
+			setDefaultSecuritySettingsBruteForceDetection(realm)
+		}
+	} else {
+		setDefaultSecuritySettingHeaders(realm)
+		setDefaultSecuritySettingsBruteForceDetection(realm)
+	}
+
+	if passwordPolicy, ok := data.GetOk("password_policy"); ok {
+		realm.PasswordPolicy = passwordPolicy.(string)
+	}
+
+	if passwordPolicyRules, ok := data.GetOk("password_policy_rule"); ok {
+		realm.PasswordPolicy = keycloak.FormatPasswordPolicy(getPasswordPolicyRulesFromData(passwordPolicyRules.(*schema.Set).List()))
+	}
+
+	// flow bindings are only sent when they change, so that bindings managed by keycloak_authentication_bindings are kept
+	for attribute, binding := range map[string]**string{
+		"browser_flow":               &realm.BrowserFlow,
+		"registration_flow":          &realm.RegistrationFlow,
+		"direct_grant_flow":          &realm.DirectGrantFlow,
+		"reset_credentials_flow":     &realm.ResetCredentialsFlow,
+		"client_authentication_flow": &realm.ClientAuthenticationFlow,
+		"docker_authentication_flow": &realm.DockerAuthenticationFlow,
+	} {
+		if flow, ok := data.GetOk(attribute); ok && data.HasChange(attribute) {
+			*binding = stringPointer(flow.(string))
+		}
+	}
+
+	attributes := map[string]interface{}{}
+	if v, ok := data.GetOk("attributes"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			attributes[key] = value
+		}
+	}
+	realm.Attributes = attributes
+
+	defaultDefaultClientScopes := make([]string, 0)
+	if v, ok := data.GetOk("default_default_client_scopes"); ok {
+		for _, defaultDefaultClientScope := range v.(*schema.Set).List() {
+			defaultDefaultClientScopes = append(defaultDefaultClientScopes, defaultDefaultClientScope.(string))
+		}
+	}
+	realm.DefaultDefaultClientScopes = defaultDefaultClientScopes
+
+	defaultOptionalClientScopes := make([]string, 0)
+	if v, ok := data.GetOk("default_optional_client_scopes"); ok {
+		for _, defaultOptionalClientScope := range v.(*schema.Set).List() {
+			defaultOptionalClientScopes = append(defaultOptionalClientScopes, defaultOptionalClientScope.(string))
+		}
+	}
+	realm.DefaultOptionalClientScopes = defaultOptionalClientScopes
+
+	//OTPPolicy
+	if v, ok := data.GetOk("otp_policy"); ok {
+		setRealmOtpPolicyFromSettings(realm, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	//CIBA
+	if v, ok := data.GetOk("ciba_policy"); ok {
+		err := setRealmCibaPolicyFromSettings(realm, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	//WebAuthn
+	if v, ok := data.GetOk("web_authn_policy"); ok {
+		setRealmWebAuthnPolicyFromSettings(realm, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	//WebAuthn Passwordless
+	if v, ok := data.GetOk("web_authn_passwordless_policy"); ok {
+		setRealmWebAuthnPasswordlessPolicyFromSettings(realm, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return realm, nil
+}
+
+func setDefaultSecuritySettingHeaders(realm *keycloak.Realm) {
+	realm.BrowserSecurityHeaders = keycloak.BrowserSecurityHeaders{
+		ContentSecurityPolicy:           "frame-src 'self'; frame-ancestors 'self'; object-src 'none';",
+		ContentSecurityPolicyReportOnly: "",
+		StrictTransportSecurity:         "max-age=31536000; includeSubDomains",
+		XContentTypeOptions:             "nosniff",
+		XFrameOptions:                   "SAMEORIGIN",
+		XRobotsTag:                      "none",
+		XXSSProtection:                  "1; mode=block",
+		ReferrerPolicy:                  "no-referrer",
+	}
+}
+
+func setDefaultSecuritySettingsBruteForceDetection(realm *keycloak.Realm) {
+	realm.BruteForceProtected = false
+	realm.PermanentLockout = false
+	realm.FailureFactor = 30
+	realm.WaitIncrementSeconds = 60
+	realm.QuickLoginCheckMilliSeconds = 1000
+	realm.MinimumQuickLoginWaitSeconds = 60
+	realm.MaxFailureWaitSeconds = 900
+	realm.MaxDeltaTimeSeconds = 43200
+}
+
+func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
+	data.SetId(realm.Realm)
+
+	data.Set("realm", realm.Realm)
+	data.Set("internal_id", realm.Id)
+	data.Set("enabled", realm.Enabled)
+	data.Set("display_name", realm.DisplayName)
+	data.Set("display_name_html", realm.DisplayNameHtml)
+	data.Set("user_managed_access", realm.UserManagedAccess)
+	data.Set("organizations_enabled", realm.OrganizationsEnabled != nil && *realm.OrganizationsEnabled)
+
+	// Login Config
+	data.Set("registration_allowed", realm.RegistrationAllowed)
+	data.Set("registration_email_as_username", realm.RegistrationEmailAsUsername)
+	data.Set("edit_username_allowed", realm.EditUsernameAllowed)
+	data.Set("reset_password_allowed", realm.ResetPasswordAllowed)
+	data.Set("remember_me", realm.RememberMe)
+	data.Set("verify_email", realm.VerifyEmail)
+	data.Set("login_with_email_allowed", realm.LoginWithEmailAllowed)
+	data.Set("duplicate_emails_allowed", realm.DuplicateEmailsAllowed)
+	data.Set("ssl_required", realm.SslRequired)
+
+	// Smtp Config
+
+	if (keycloak.SmtpServer{}) == realm.SmtpServer {
+		data.Set("smtp_server", nil)
+	} else {
+		smtpSettings := getRealmSmtpServerSettings(realm.SmtpServer)
+		// verify_on_apply and verify_recipient are not stored in Keycloak, so the values from state are kept
+		smtpSettings["verify_on_apply"] = data.Get("smtp_server.0.verify_on_apply")
+		smtpSettings["verify_recipient"] = data.Get("smtp_server.0.verify_recipient")
+
+		data.Set("smtp_server", []interface{}{smtpSettings})
+	}
+
+	// Themes
+	data.Set("login_theme", realm.LoginTheme)
+	data.Set("account_theme", realm.AccountTheme)
+	data.Set("admin_theme", realm.AdminTheme)
+	data.Set("email_theme", realm.EmailTheme)
+
+	// Tokens
+	setRealmTokenSettingsData(data, realm)
+
+	//internationalization
+	if realm.InternationalizationEnabled {
+		internationalizationSettings := make(map[string]interface{})
+		internationalizationSettings["supported_locales"] = realm.SupportLocales
+		internationalizationSettings["default_locale"] = realm.DefaultLocale
+		data.Set("internationalization", []interface{}{internationalizationSettings})
+	} else {
+		data.Set("internationalization", nil)
 	}
-}
 
-func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
-	internationalizationEnabled := false
-	supportLocales := make([]string, 0)
-	defaultLocale := ""
-	if v, ok := data.GetOk("internationalization"); ok {
-		internationalizationEnabled = true
-		internationalizationSettings := v.([]interface{})[0].(map[string]interface{})
-		if v, ok := internationalizationSettings["supported_locales"]; ok {
-			for _, supportLocale := range v.(*schema.Set).List() {
-				supportLocales = append(supportLocales, supportLocale.(string))
-			}
+	if v, ok := data.GetOk("security_defenses"); ok {
+		oldHeadersConfig := v.([]interface{})[0].(map[string]interface{})["headers"].([]interface{})
+		if len(oldHeadersConfig) == 0 && !realm.BruteForceProtected {
+			data.Set("security_defenses", nil)
+		} else if len(oldHeadersConfig) == 1 && realm.BruteForceProtected {
+			securityDefensesSettings := make(map[string]interface{})
+			securityDefensesSettings["headers"] = []interface{}{getHeaderSettings(realm)}
+			securityDefensesSettings["brute_force_detection"] = []interface{}{getBruteForceDetectionSettings(realm)}
+			data.Set("security_defenses", []interface{}{securityDefensesSettings})
+		} else if len(oldHeadersConfig) == 1 {
+			securityDefensesSettings := make(map[string]interface{})
+			securityDefensesSettings["headers"] = []interface{}{getHeaderSettings(realm)}
+			data.Set("security_defenses", []interface{}{securityDefensesSettings})
+		} else if realm.BruteForceProtected {
+			securityDefensesSettings := make(map[string]interface{})
+			securityDefensesSettings["brute_force_detection"] = []interface{}{getBruteForceDetectionSettings(realm)}
+			data.Set("security_defenses", []interface{}{securityDefensesSettings})
 		}
-		defaultLocale = internationalizationSettings["default_locale"].(string)
 	}
 
-	realmId := data.Get("realm")
-	internalId := data.Get("internal_id")
-	if internalId != "" {
-		realmId = internalId
+	// only the form of the password policy that is in use is set, so that the other form does not show a diff
+	if _, ok := data.GetOk("password_policy_rule"); ok {
+		data.Set("password_policy", "")
+		data.Set("password_policy_rule", getPasswordPolicyRulesData(realm.PasswordPolicy))
+	} else {
+		data.Set("password_policy", realm.PasswordPolicy)
+		data.Set("password_policy_rule", nil)
 	}
 
-	realm := &keycloak.Realm{
-		Id:                realmId.(string),
-		Realm:             data.Get("realm").(string),
-		Enabled:           data.Get("enabled").(bool),
-		DisplayName:       data.Get("display_name").(string),
-		DisplayNameHtml:   data.Get("display_name_html").(string),
-		UserManagedAccess: data.Get("user_managed_access").(bool),
+	//Flow Bindings
+	data.Set("browser_flow", realm.BrowserFlow)
+	data.Set("registration_flow", realm.RegistrationFlow)
+	data.Set("direct_grant_flow", realm.DirectGrantFlow)
+	data.Set("reset_credentials_flow", realm.ResetCredentialsFlow)
+	data.Set("client_authentication_flow", realm.ClientAuthenticationFlow)
+	data.Set("docker_authentication_flow", realm.DockerAuthenticationFlow)
 
-		// Login Config
-		RegistrationAllowed:         data.Get("registration_allowed").(bool),
-		RegistrationEmailAsUsername: data.Get("registration_email_as_username").(bool),
-		EditUsernameAllowed:         data.Get("edit_username_allowed").(bool),
-		ResetPasswordAllowed:        data.Get("reset_password_allowed").(bool),
-		RememberMe:                  data.Get("remember_me").(bool),
-		VerifyEmail:                 data.Get("verify_email").(bool),
-		LoginWithEmailAllowed:       data.Get("login_with_email_allowed").(bool),
-		DuplicateEmailsAllowed:      data.Get("duplicate_emails_allowed").(bool),
-		SslRequired:                 data.Get("ssl_required").(string),
+	//WebAuthn
+	data.Set("web_authn_policy", []interface{}{getRealmWebAuthnPolicySettings(realm)})
 
-		//internationalization
-		InternationalizationEnabled: internationalizationEnabled,
-		SupportLocales:              supportLocales,
-		DefaultLocale:               defaultLocale,
+	//OTP Policy
+	data.Set("otp_policy", []interface{}{getRealmOtpPolicySettings(realm)})
+
+	//CIBA
+	if _, ok := realm.Attributes[keycloak.RealmAttributeCibaBackchannelTokenDeliveryMode]; ok {
+		data.Set("ciba_policy", []interface{}{getRealmCibaPolicySettings(realm)})
 	}
 
-	//smtp
-	if v, ok := data.GetOk("smtp_server"); ok {
-		smtpSettings := v.([]interface{})[0].(map[string]interface{})
+	//WebAuthn Passwordless
+	data.Set("web_authn_passwordless_policy", []interface{}{getRealmWebAuthnPasswordlessPolicySettings(realm)})
 
-		smtpServer := keycloak.SmtpServer{
-			StartTls:           types.KeycloakBoolQuoted(smtpSettings["starttls"].(bool)),
-			Port:               smtpSettings["port"].(string),
-			Host:               smtpSettings["host"].(string),
-			ReplyTo:            smtpSettings["reply_to"].(string),
-			ReplyToDisplayName: smtpSettings["reply_to_display_name"].(string),
-			From:               smtpSettings["from"].(string),
-			FromDisplayName:    smtpSettings["from_display_name"].(string),
-			EnvelopeFrom:       smtpSettings["envelope_from"].(string),
-			Ssl:                types.KeycloakBoolQuoted(smtpSettings["ssl"].(bool)),
+	attributes := map[string]interface{}{}
+	if v, ok := data.GetOk("attributes"); ok {
+		for key := range v.(map[string]interface{}) {
+			attributes[key] = realm.Attributes[key]
+			//We are only interested in attributes managed in terraform (Keycloak returns a lot of doubles values in the attributes...)
 		}
+	}
+	data.Set("attributes", attributes)
 
-		authConfig := smtpSettings["auth"].([]interface{})
-		if len(authConfig) == 1 {
-			auth := authConfig[0].(map[string]interface{})
+	// default and optional client scope mappings
+	data.Set("default_default_client_scopes", realm.DefaultDefaultClientScopes)
+	data.Set("default_optional_client_scopes", realm.DefaultOptionalClientScopes)
+}
 
-			smtpServer.Auth = true
-			smtpServer.User = auth["username"].(string)
-			smtpServer.Password = auth["password"].(string)
-		} else {
-			smtpServer.Auth = false
-		}
+func getBruteForceDetectionSettings(realm *keycloak.Realm) map[string]interface{} {
+	bruteForceDetectionSettings := make(map[string]interface{})
+	bruteForceDetectionSettings["permanent_lockout"] = realm.PermanentLockout
+	bruteForceDetectionSettings["max_login_failures"] = realm.FailureFactor
+	bruteForceDetectionSettings["wait_increment_seconds"] = realm.WaitIncrementSeconds
+	bruteForceDetectionSettings["quick_login_check_milli_seconds"] = realm.QuickLoginCheckMilliSeconds
+	bruteForceDetectionSettings["minimum_quick_login_wait_seconds"] = realm.MinimumQuickLoginWaitSeconds
+	bruteForceDetectionSettings["max_failure_wait_seconds"] = realm.MaxFailureWaitSeconds
+	bruteForceDetectionSettings["failure_reset_time_seconds"] = realm.MaxDeltaTimeSeconds
+	return bruteForceDetectionSettings
+}
 
-		realm.SmtpServer = smtpServer
-	}
+func getHeaderSettings(realm *keycloak.Realm) map[string]interface{} {
+	headersSettings := make(map[string]interface{})
+	headersSettings["content_security_policy"] = realm.BrowserSecurityHeaders.ContentSecurityPolicy
+	headersSettings["content_security_policy_report_only"] = realm.BrowserSecurityHeaders.ContentSecurityPolicyReportOnly
+	headersSettings["strict_transport_security"] = realm.BrowserSecurityHeaders.StrictTransportSecurity
+	headersSettings["x_content_type_options"] = realm.BrowserSecurityHeaders.XContentTypeOptions
+	headersSettings["x_frame_options"] = realm.BrowserSecurityHeaders.XFrameOptions
+	headersSettings["x_robots_tag"] = realm.BrowserSecurityHeaders.XRobotsTag
+	headersSettings["x_xss_protection"] = realm.BrowserSecurityHeaders.XXSSProtection
+	headersSettings["referrer_policy"] = realm.BrowserSecurityHeaders.ReferrerPolicy
+	return headersSettings
+}
 
-	// Themes
+func getPasswordPolicyRulesFromData(passwordPolicyRules []interface{}) []keycloak.PasswordPolicyRule {
+	var rules []keycloak.PasswordPolicyRule
 
-	if loginTheme, ok := data.GetOk("login_theme"); ok {
-		realm.LoginTheme = loginTheme.(string)
+	for _, v := range passwordPolicyRules {
+		rule := v.(map[string]interface{})
+
+		rules = append(rules, keycloak.PasswordPolicyRule{
+			Type:  rule["type"].(string),
+			Value: rule["value"].(string),
+		})
 	}
 
-	if accountTheme, ok := data.GetOk("account_theme"); ok {
-		realm.AccountTheme = accountTheme.(string)
+	return rules
+}
+
+func getPasswordPolicyRulesData(passwordPolicy string) []interface{} {
+	var rules []interface{}
+
+	for _, rule := range keycloak.ParsePasswordPolicy(passwordPolicy) {
+		rules = append(rules, map[string]interface{}{
+			"type":  rule.Type,
+			"value": rule.Value,
+		})
 	}
 
-	if adminTheme, ok := data.GetOk("admin_theme"); ok {
-		realm.AdminTheme = adminTheme.(string)
+	return rules
+}
+
+func getRealmSmtpServerFromSettings(smtpSettings map[string]interface{}) keycloak.SmtpServer {
+	smtpServer := keycloak.SmtpServer{
+		StartTls:           types.KeycloakBoolQuoted(smtpSettings["starttls"].(bool)),
+		Port:               smtpSettings["port"].(string),
+		Host:               smtpSettings["host"].(string),
+		ReplyTo:            smtpSettings["reply_to"].(string),
+		ReplyToDisplayName: smtpSettings["reply_to_display_name"].(string),
+		From:               smtpSettings["from"].(string),
+		FromDisplayName:    smtpSettings["from_display_name"].(string),
+		EnvelopeFrom:       smtpSettings["envelope_from"].(string),
+		Ssl:                types.KeycloakBoolQuoted(smtpSettings["ssl"].(bool)),
 	}
 
-	if emailTheme, ok := data.GetOk("email_theme"); ok {
-		realm.EmailTheme = emailTheme.(string)
+	authConfig := smtpSettings["auth"].([]interface{})
+	if len(authConfig) == 1 {
+		auth := authConfig[0].(map[string]interface{})
+
+		smtpServer.Auth = true
+		smtpServer.User = auth["username"].(string)
+		smtpServer.Password = auth["password"].(string)
+	} else {
+		smtpServer.Auth = false
 	}
 
-	// Tokens
+	return smtpServer
+}
 
+func setRealmTokenSettingsFromData(data *schema.ResourceData, realm *keycloak.Realm) error {
 	if defaultSignatureAlgorithm, ok := data.GetOk("default_signature_algorithm"); ok {
 		realm.DefaultSignatureAlgorithm = defaultSignatureAlgorithm.(string)
 	}
 
-	if revokeRefreshToken, ok := data.GetOk("revoke_refresh_token"); ok {
-		realm.RevokeRefreshToken = revokeRefreshToken.(bool)
-	}
+	realm.RevokeRefreshToken = data.Get("revoke_refresh_token").(bool)
 
-	if refreshTokenMaxReuse, ok := data.GetOk("refresh_token_max_reuse"); ok {
-		realm.RefreshTokenMaxReuse = refreshTokenMaxReuse.(int)
-	}
+	realm.RefreshTokenMaxReuse = data.Get("refresh_token_max_reuse").(int)
 
 	if ssoSessionIdleTimeout := data.Get("sso_session_idle_timeout").(string); ssoSessionIdleTimeout != "" {
 		ssoSessionIdleTimeoutDurationString, err := getSecondsFromDurationString(ssoSessionIdleTimeout)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.SsoSessionIdleTimeout = ssoSessionIdleTimeoutDurationString
 	}
@@ -836,7 +1261,7 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if ssoSessionMaxLifespan := data.Get("sso_session_max_lifespan").(string); ssoSessionMaxLifespan != "" {
 		ssoSessionMaxLifespanDurationString, err := getSecondsFromDurationString(ssoSessionMaxLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.SsoSessionMaxLifespan = ssoSessionMaxLifespanDurationString
 	}
@@ -844,7 +1269,7 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if ssoSessionIdleTimeoutRememberMe := data.Get("sso_session_idle_timeout_remember_me").(string); ssoSessionIdleTimeoutRememberMe != "" {
 		ssoSessionIdleTimeoutRememberMeDurationString, err := getSecondsFromDurationString(ssoSessionIdleTimeoutRememberMe)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.SsoSessionIdleTimeoutRememberMe = ssoSessionIdleTimeoutRememberMeDurationString
 	}
@@ -852,7 +1277,7 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if ssoSessionMaxLifespanRememberMe := data.Get("sso_session_max_lifespan_remember_me").(string); ssoSessionMaxLifespanRememberMe != "" {
 		ssoSessionMaxLifespanRememberMeDurationString, err := getSecondsFromDurationString(ssoSessionMaxLifespanRememberMe)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.SsoSessionMaxLifespanRememberMe = ssoSessionMaxLifespanRememberMeDurationString
 	}
@@ -860,7 +1285,7 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if offlineSessionIdleTimeout := data.Get("offline_session_idle_timeout").(string); offlineSessionIdleTimeout != "" {
 		offlineSessionIdleTimeoutDurationString, err := getSecondsFromDurationString(offlineSessionIdleTimeout)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.OfflineSessionIdleTimeout = offlineSessionIdleTimeoutDurationString
 	}
@@ -868,19 +1293,17 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if offlineSessionMaxLifespan := data.Get("offline_session_max_lifespan").(string); offlineSessionMaxLifespan != "" {
 		offlineSessionMaxLifespanDurationString, err := getSecondsFromDurationString(offlineSessionMaxLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.OfflineSessionMaxLifespan = offlineSessionMaxLifespanDurationString
 	}
 
-	if offlineSessionMaxLifespanEnabled, ok := data.GetOk("offline_session_max_lifespan_enabled"); ok {
-		realm.OfflineSessionMaxLifespanEnabled = offlineSessionMaxLifespanEnabled.(bool)
-	}
+	realm.OfflineSessionMaxLifespanEnabled = data.Get("offline_session_max_lifespan_enabled").(bool)
 
 	if clientSessionIdleTimeout := data.Get("client_session_idle_timeout").(string); clientSessionIdleTimeout != "" {
 		clientSessionIdleTimeoutDurationString, err := getSecondsFromDurationString(clientSessionIdleTimeout)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.ClientSessionIdleTimeout = clientSessionIdleTimeoutDurationString
 	}
@@ -888,7 +1311,7 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if clientSessionMaxLifespan := data.Get("client_session_max_lifespan").(string); clientSessionMaxLifespan != "" {
 		clientSessionMaxLifespanDurationString, err := getSecondsFromDurationString(clientSessionMaxLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.ClientSessionMaxLifespan = clientSessionMaxLifespanDurationString
 	}
@@ -896,7 +1319,7 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if accessTokenLifespan := data.Get("access_token_lifespan").(string); accessTokenLifespan != "" {
 		accessTokenLifespanDurationString, err := getSecondsFromDurationString(accessTokenLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.AccessTokenLifespan = accessTokenLifespanDurationString
 	}
@@ -904,329 +1327,218 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if accessTokenLifespanForImplicitFlow := data.Get("access_token_lifespan_for_implicit_flow").(string); accessTokenLifespanForImplicitFlow != "" {
 		accessTokenLifespanForImplicitFlowDurationString, err := getSecondsFromDurationString(accessTokenLifespanForImplicitFlow)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.AccessTokenLifespanForImplicitFlow = accessTokenLifespanForImplicitFlowDurationString
 	}
 
 	if accessCodeLifespan := data.Get("access_code_lifespan").(string); accessCodeLifespan != "" {
-		accessCodeLifespanDurationString, err := getSecondsFromDurationString(accessCodeLifespan)
-		if err != nil {
-			return nil, err
-		}
-		realm.AccessCodeLifespan = accessCodeLifespanDurationString
-	}
-
-	if accessCodeLifespanLogin := data.Get("access_code_lifespan_login").(string); accessCodeLifespanLogin != "" {
-		accessCodeLifespanLoginDurationString, err := getSecondsFromDurationString(accessCodeLifespanLogin)
-		if err != nil {
-			return nil, err
-		}
-		realm.AccessCodeLifespanLogin = accessCodeLifespanLoginDurationString
-	}
-
-	if accessCodeLifespanUserAction := data.Get("access_code_lifespan_user_action").(string); accessCodeLifespanUserAction != "" {
-		accessCodeLifespanUserActionDurationString, err := getSecondsFromDurationString(accessCodeLifespanUserAction)
-		if err != nil {
-			return nil, err
-		}
-		realm.AccessCodeLifespanUserAction = accessCodeLifespanUserActionDurationString
-	}
-
-	if actionTokenGeneratedByUserLifespan := data.Get("action_token_generated_by_user_lifespan").(string); actionTokenGeneratedByUserLifespan != "" {
-		actionTokenGeneratedByUserLifespanDurationString, err := getSecondsFromDurationString(actionTokenGeneratedByUserLifespan)
-		if err != nil {
-			return nil, err
-		}
-		realm.ActionTokenGeneratedByUserLifespan = actionTokenGeneratedByUserLifespanDurationString
-	}
-
-	if actionTokenGeneratedByAdminLifespan := data.Get("action_token_generated_by_admin_lifespan").(string); actionTokenGeneratedByAdminLifespan != "" {
-		actionTokenGeneratedByAdminLifespanDurationString, err := getSecondsFromDurationString(actionTokenGeneratedByAdminLifespan)
-		if err != nil {
-			return nil, err
-		}
-		realm.ActionTokenGeneratedByAdminLifespan = actionTokenGeneratedByAdminLifespanDurationString
-	}
-
-	if oauth2DeviceCodeLifespan := data.Get("oauth2_device_code_lifespan").(string); oauth2DeviceCodeLifespan != "" {
-		oauth2DeviceCodeLifespanDurationString, err := getSecondsFromDurationString(oauth2DeviceCodeLifespan)
-		if err != nil {
-			return nil, err
-		}
-		realm.Oauth2DeviceCodeLifespan = oauth2DeviceCodeLifespanDurationString
-	}
-
-	if oauth2DevicePollingInterval, ok := data.GetOk("oauth2_device_polling_interval"); ok {
-		realm.Oauth2DevicePollingInterval = oauth2DevicePollingInterval.(int)
-	}
-
-	//security defenses
-	if v, ok := data.GetOk("security_defenses"); ok {
-		securityDefensesSettings := v.([]interface{})[0].(map[string]interface{})
-
-		headersConfig := securityDefensesSettings["headers"].([]interface{})
-		if len(headersConfig) == 1 {
-			headerSettings := headersConfig[0].(map[string]interface{})
-
-			realm.BrowserSecurityHeaders = keycloak.BrowserSecurityHeaders{
-				ContentSecurityPolicy:           headerSettings["content_security_policy"].(string),
-				ContentSecurityPolicyReportOnly: headerSettings["content_security_policy_report_only"].(string),
-				StrictTransportSecurity:         headerSettings["strict_transport_security"].(string),
-				XContentTypeOptions:             headerSettings["x_content_type_options"].(string),
-				XFrameOptions:                   headerSettings["x_frame_options"].(string),
-				XRobotsTag:                      headerSettings["x_robots_tag"].(string),
-				XXSSProtection:                  headerSettings["x_xss_protection"].(string),
-				ReferrerPolicy:                  headerSettings["referrer_policy"].(string),
-			}
-		} else {
-			setDefaultSecuritySettingHeaders(realm)
-		}
-
-		bruteForceDetectionConfig := securityDefensesSettings["brute_force_detection"].([]interface{})
-		if len(bruteForceDetectionConfig) == 1 {
-			bruteForceDetectionSettings := bruteForceDetectionConfig[0].(map[string]interface{})
-			realm.BruteForceProtected = true
-			realm.PermanentLockout = bruteForceDetectionSettings["permanent_lockout"].(bool)
-			realm.FailureFactor = bruteForceDetectionSettings["max_login_failures"].(int)
-			realm.WaitIncrementSeconds = bruteForceDetectionSettings["wait_increment_seconds"].(int)
-			realm.QuickLoginCheckMilliSeconds = bruteForceDetectionSettings["quick_login_check_milli_seconds"].(int)
-			realm.MinimumQuickLoginWaitSeconds = bruteForceDetectionSettings["minimum_quick_login_wait_seconds"].(int)
-			realm.MaxFailureWaitSeconds = bruteForceDetectionSettings["max_failure_wait_seconds"].(int)
-			realm.MaxDeltaTimeSeconds = bruteForceDetectionSettings["failure_reset_time_seconds"].(int)
-		} else {
-			setDefaultSecuritySettingsBruteForceDetection(realm)
-		}
-	} else {
-		setDefaultSecuritySettingHeaders(realm)
-		setDefaultSecuritySettingsBruteForceDetection(realm)
-	}
-
-	if passwordPolicy, ok := data.GetOk("password_policy"); ok {
-		realm.PasswordPolicy = passwordPolicy.(string)
-	}
-
-	setRealmFlowBindings(data, realm)
-
-	attributes := map[string]interface{}{}
-	if v, ok := data.GetOk("attributes"); ok {
-		for key, value := range v.(map[string]interface{}) {
-			attributes[key] = value
-		}
-	}
-	realm.Attributes = attributes
-
-	defaultDefaultClientScopes := make([]string, 0)
-	if v, ok := data.GetOk("default_default_client_scopes"); ok {
-		for _, defaultDefaultClientScope := range v.(*schema.Set).List() {
-			defaultDefaultClientScopes = append(defaultDefaultClientScopes, defaultDefaultClientScope.(string))
-		}
-	}
-	realm.DefaultDefaultClientScopes = defaultDefaultClientScopes
-
-	defaultOptionalClientScopes := make([]string, 0)
-	if v, ok := data.GetOk("default_optional_client_scopes"); ok {
-		for _, defaultOptionalClientScope := range v.(*schema.Set).List() {
-			defaultOptionalClientScopes = append(defaultOptionalClientScopes, defaultOptionalClientScope.(string))
+		accessCodeLifespanDurationString, err := getSecondsFromDurationString(accessCodeLifespan)
+		if err != nil {
+			return err
 		}
+		realm.AccessCodeLifespan = accessCodeLifespanDurationString
 	}
-	realm.DefaultOptionalClientScopes = defaultOptionalClientScopes
 
-	//OTPPolicy
-	if v, ok := data.GetOk("otp_policy"); ok {
-		otpPolicy := v.([]interface{})[0].(map[string]interface{})
-
-		if otpPolicyAlgorithm, ok := otpPolicy["algorithm"]; ok {
-			realm.OTPPolicyAlgorithm = otpPolicyAlgorithm.(string)
+	if accessCodeLifespanLogin := data.Get("access_code_lifespan_login").(string); accessCodeLifespanLogin != "" {
+		accessCodeLifespanLoginDurationString, err := getSecondsFromDurationString(accessCodeLifespanLogin)
+		if err != nil {
+			return err
 		}
+		realm.AccessCodeLifespanLogin = accessCodeLifespanLoginDurationString
+	}
 
-		if otpPolicyDigits, ok := otpPolicy["digits"]; ok {
-			realm.OTPPolicyDigits = otpPolicyDigits.(int)
+	if accessCodeLifespanUserAction := data.Get("access_code_lifespan_user_action").(string); accessCodeLifespanUserAction != "" {
+		accessCodeLifespanUserActionDurationString, err := getSecondsFromDurationString(accessCodeLifespanUserAction)
+		if err != nil {
+			return err
 		}
+		realm.AccessCodeLifespanUserAction = accessCodeLifespanUserActionDurationString
+	}
 
-		if otpPolicyInitialCounter, ok := otpPolicy["initial_counter"]; ok {
-			realm.OTPPolicyInitialCounter = otpPolicyInitialCounter.(int)
+	if actionTokenGeneratedByUserLifespan := data.Get("action_token_generated_by_user_lifespan").(string); actionTokenGeneratedByUserLifespan != "" {
+		actionTokenGeneratedByUserLifespanDurationString, err := getSecondsFromDurationString(actionTokenGeneratedByUserLifespan)
+		if err != nil {
+			return err
 		}
+		realm.ActionTokenGeneratedByUserLifespan = actionTokenGeneratedByUserLifespanDurationString
+	}
 
-		if otpPolicyLookAheadWindow, ok := otpPolicy["look_ahead_window"]; ok {
-			realm.OTPPolicyLookAheadWindow = otpPolicyLookAheadWindow.(int)
+	if actionTokenGeneratedByAdminLifespan := data.Get("action_token_generated_by_admin_lifespan").(string); actionTokenGeneratedByAdminLifespan != "" {
+		actionTokenGeneratedByAdminLifespanDurationString, err := getSecondsFromDurationString(actionTokenGeneratedByAdminLifespan)
+		if err != nil {
+			return err
 		}
+		realm.ActionTokenGeneratedByAdminLifespan = actionTokenGeneratedByAdminLifespanDurationString
+	}
 
-		if otpPolicyPeriod, ok := otpPolicy["period"]; ok {
-			realm.OTPPolicyPeriod = otpPolicyPeriod.(int)
+	if oauth2DeviceCodeLifespan := data.Get("oauth2_device_code_lifespan").(string); oauth2DeviceCodeLifespan != "" {
+		oauth2DeviceCodeLifespanDurationString, err := getSecondsFromDurationString(oauth2DeviceCodeLifespan)
+		if err != nil {
+			return err
 		}
+		realm.Oauth2DeviceCodeLifespan = oauth2DeviceCodeLifespanDurationString
+	}
 
-		if otpPolicyType, ok := otpPolicy["type"]; ok {
-			realm.OTPPolicyType = otpPolicyType.(string)
-		}
+	if oauth2DevicePollingInterval, ok := data.GetOk("oauth2_device_polling_interval"); ok {
+		realm.Oauth2DevicePollingInterval = oauth2DevicePollingInterval.(int)
 	}
 
-	//WebAuthn
-	if v, ok := data.GetOk("web_authn_policy"); ok {
-		webAuthnPolicy := v.([]interface{})[0].(map[string]interface{})
+	return nil
+}
 
-		realm.WebAuthnPolicyAcceptableAaguids = interfaceSliceToStringSlice(webAuthnPolicy["acceptable_aaguids"].(*schema.Set).List())
+func getBrowserSecurityHeadersFromSettings(headersSettings map[string]interface{}) keycloak.BrowserSecurityHeaders {
+	return keycloak.BrowserSecurityHeaders{
+		ContentSecurityPolicy:           headersSettings["content_security_policy"].(string),
+		ContentSecurityPolicyReportOnly: headersSettings["content_security_policy_report_only"].(string),
+		StrictTransportSecurity:         headersSettings["strict_transport_security"].(string),
+		XContentTypeOptions:             headersSettings["x_content_type_options"].(string),
+		XFrameOptions:                   headersSettings["x_frame_options"].(string),
+		XRobotsTag:                      headersSettings["x_robots_tag"].(string),
+		XXSSProtection:                  headersSettings["x_xss_protection"].(string),
+		ReferrerPolicy:                  headersSettings["referrer_policy"].(string),
+	}
+}
 
-		if webAuthnPolicyAttestationConveyancePreference, ok := webAuthnPolicy["attestation_conveyance_preference"]; ok {
-			realm.WebAuthnPolicyAttestationConveyancePreference = webAuthnPolicyAttestationConveyancePreference.(string)
-		}
+func setRealmBruteForceDetectionFromSettings(realm *keycloak.Realm, bruteForceDetectionSettings map[string]interface{}) {
+	realm.BruteForceProtected = true
+	realm.PermanentLockout = bruteForceDetectionSettings["permanent_lockout"].(bool)
+	realm.FailureFactor = bruteForceDetectionSettings["max_login_failures"].(int)
+	realm.WaitIncrementSeconds = bruteForceDetectionSettings["wait_increment_seconds"].(int)
+	realm.QuickLoginCheckMilliSeconds = bruteForceDetectionSettings["quick_login_check_milli_seconds"].(int)
+	realm.MinimumQuickLoginWaitSeconds = bruteForceDetectionSettings["minimum_quick_login_wait_seconds"].(int)
+	realm.MaxFailureWaitSeconds = bruteForceDetectionSettings["max_failure_wait_seconds"].(int)
+	realm.MaxDeltaTimeSeconds = bruteForceDetectionSettings["failure_reset_time_seconds"].(int)
+}
 
-		if webAuthnPolicyAuthenticatorAttachment, ok := webAuthnPolicy["authenticator_attachment"]; ok {
-			realm.WebAuthnPolicyAuthenticatorAttachment = webAuthnPolicyAuthenticatorAttachment.(string)
-		}
+func setRealmOtpPolicyFromSettings(realm *keycloak.Realm, otpPolicy map[string]interface{}) {
+	if otpPolicyAlgorithm, ok := otpPolicy["algorithm"]; ok {
+		realm.OTPPolicyAlgorithm = otpPolicyAlgorithm.(string)
+	}
 
-		if webAuthnPolicyAvoidSameAuthenticatorRegister, ok := webAuthnPolicy["avoid_same_authenticator_register"]; ok {
-			realm.WebAuthnPolicyAvoidSameAuthenticatorRegister = webAuthnPolicyAvoidSameAuthenticatorRegister.(bool)
-		}
+	if otpPolicyDigits, ok := otpPolicy["digits"]; ok {
+		realm.OTPPolicyDigits = otpPolicyDigits.(int)
+	}
 
-		if webAuthnPolicyCreateTimeout, ok := webAuthnPolicy["create_timeout"]; ok {
-			realm.WebAuthnPolicyCreateTimeout = webAuthnPolicyCreateTimeout.(int)
-		}
+	if otpPolicyInitialCounter, ok := otpPolicy["initial_counter"]; ok {
+		realm.OTPPolicyInitialCounter = otpPolicyInitialCounter.(int)
+	}
 
-		if webAuthnPolicyRequireResidentKey, ok := webAuthnPolicy["require_resident_key"]; ok {
-			realm.WebAuthnPolicyRequireResidentKey = webAuthnPolicyRequireResidentKey.(string)
-		}
+	if otpPolicyLookAheadWindow, ok := otpPolicy["look_ahead_window"]; ok {
+		realm.OTPPolicyLookAheadWindow = otpPolicyLookAheadWindow.(int)
+	}
 
-		if webAuthnPolicyRpEntityName, ok := webAuthnPolicy["relying_party_entity_name"]; ok {
-			realm.WebAuthnPolicyRpEntityName = webAuthnPolicyRpEntityName.(string)
-		}
+	if otpPolicyPeriod, ok := otpPolicy["period"]; ok {
+		realm.OTPPolicyPeriod = otpPolicyPeriod.(int)
+	}
 
-		if webAuthnPolicyRpId, ok := webAuthnPolicy["relying_party_id"]; ok {
-			realm.WebAuthnPolicyRpId = webAuthnPolicyRpId.(string)
-		}
+	if otpPolicyType, ok := otpPolicy["type"]; ok {
+		realm.OTPPolicyType = otpPolicyType.(string)
+	}
+}
 
-		realm.WebAuthnPolicySignatureAlgorithms = interfaceSliceToStringSlice(webAuthnPolicy["signature_algorithms"].(*schema.Set).List())
+func setRealmWebAuthnPolicyFromSettings(realm *keycloak.Realm, webAuthnPolicy map[string]interface{}) {
+	realm.WebAuthnPolicyAcceptableAaguids = interfaceSliceToStringSlice(webAuthnPolicy["acceptable_aaguids"].(*schema.Set).List())
 
-		if webAuthnPolicyUserVerificationRequirement, ok := webAuthnPolicy["user_verification_requirement"]; ok {
-			realm.WebAuthnPolicyUserVerificationRequirement = webAuthnPolicyUserVerificationRequirement.(string)
-		}
+	if webAuthnPolicyAttestationConveyancePreference, ok := webAuthnPolicy["attestation_conveyance_preference"]; ok {
+		realm.WebAuthnPolicyAttestationConveyancePreference = webAuthnPolicyAttestationConveyancePreference.(string)
 	}
 
-	//WebAuthn Passwordless
-	if v, ok := data.GetOk("web_authn_passwordless_policy"); ok {
-		webAuthnPasswordlessPolicy := v.([]interface{})[0].(map[string]interface{})
-
-		realm.WebAuthnPolicyPasswordlessAcceptableAaguids = interfaceSliceToStringSlice(webAuthnPasswordlessPolicy["acceptable_aaguids"].(*schema.Set).List())
+	if webAuthnPolicyAuthenticatorAttachment, ok := webAuthnPolicy["authenticator_attachment"]; ok {
+		realm.WebAuthnPolicyAuthenticatorAttachment = webAuthnPolicyAuthenticatorAttachment.(string)
+	}
 
-		if webAuthnPolicyPasswordlessAttestationConveyancePreference, ok := webAuthnPasswordlessPolicy["attestation_conveyance_preference"]; ok {
-			realm.WebAuthnPolicyPasswordlessAttestationConveyancePreference = webAuthnPolicyPasswordlessAttestationConveyancePreference.(string)
-		}
+	if webAuthnPolicyAvoidSameAuthenticatorRegister, ok := webAuthnPolicy["avoid_same_authenticator_register"]; ok {
+		realm.WebAuthnPolicyAvoidSameAuthenticatorRegister = webAuthnPolicyAvoidSameAuthenticatorRegister.(bool)
+	}
 
-		if webAuthnPolicyPasswordlessAuthenticatorAttachment, ok := webAuthnPasswordlessPolicy["authenticator_attachment"]; ok {
-			realm.WebAuthnPolicyPasswordlessAuthenticatorAttachment = webAuthnPolicyPasswordlessAuthenticatorAttachment.(string)
-		}
+	if webAuthnPolicyCreateTimeout, ok := webAuthnPolicy["create_timeout"]; ok {
+		realm.WebAuthnPolicyCreateTimeout = webAuthnPolicyCreateTimeout.(int)
+	}
 
-		if webAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister, ok := webAuthnPasswordlessPolicy["avoid_same_authenticator_register"]; ok {
-			realm.WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister = webAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister.(bool)
-		}
+	if webAuthnPolicyRequireResidentKey, ok := webAuthnPolicy["require_resident_key"]; ok {
+		realm.WebAuthnPolicyRequireResidentKey = webAuthnPolicyRequireResidentKey.(string)
+	}
 
-		if webAuthnPolicyPasswordlessCreateTimeout, ok := webAuthnPasswordlessPolicy["create_timeout"]; ok {
-			realm.WebAuthnPolicyPasswordlessCreateTimeout = webAuthnPolicyPasswordlessCreateTimeout.(int)
-		}
+	if webAuthnPolicyRpEntityName, ok := webAuthnPolicy["relying_party_entity_name"]; ok {
+		realm.WebAuthnPolicyRpEntityName = webAuthnPolicyRpEntityName.(string)
+	}
 
-		if webAuthnPolicyPasswordlessRequireResidentKey, ok := webAuthnPasswordlessPolicy["require_resident_key"]; ok {
-			realm.WebAuthnPolicyPasswordlessRequireResidentKey = webAuthnPolicyPasswordlessRequireResidentKey.(string)
-		}
+	if webAuthnPolicyRpId, ok := webAuthnPolicy["relying_party_id"]; ok {
+		realm.WebAuthnPolicyRpId = webAuthnPolicyRpId.(string)
+	}
 
-		if webAuthnPolicyPasswordlessRpEntityName, ok := webAuthnPasswordlessPolicy["relying_party_entity_name"]; ok {
-			realm.WebAuthnPolicyPasswordlessRpEntityName = webAuthnPolicyPasswordlessRpEntityName.(string)
-		}
+	realm.WebAuthnPolicySignatureAlgorithms = interfaceSliceToStringSlice(webAuthnPolicy["signature_algorithms"].(*schema.Set).List())
 
-		if webAuthnPolicyPasswordlessRpId, ok := webAuthnPasswordlessPolicy["relying_party_id"]; ok {
-			realm.WebAuthnPolicyPasswordlessRpId = webAuthnPolicyPasswordlessRpId.(string)
-		}
+	if webAuthnPolicyUserVerificationRequirement, ok := webAuthnPolicy["user_verification_requirement"]; ok {
+		realm.WebAuthnPolicyUserVerificationRequirement = webAuthnPolicyUserVerificationRequirement.(string)
+	}
+}
 
-		realm.WebAuthnPolicyPasswordlessSignatureAlgorithms = interfaceSliceToStringSlice(webAuthnPasswordlessPolicy["signature_algorithms"].(*schema.Set).List())
+func setRealmWebAuthnPasswordlessPolicyFromSettings(realm *keycloak.Realm, webAuthnPasswordlessPolicy map[string]interface{}) {
+	realm.WebAuthnPolicyPasswordlessAcceptableAaguids = interfaceSliceToStringSlice(webAuthnPasswordlessPolicy["acceptable_aaguids"].(*schema.Set).List())
 
-		if webAuthnPolicyPasswordlessUserVerificationRequirement, ok := webAuthnPasswordlessPolicy["user_verification_requirement"]; ok {
-			realm.WebAuthnPolicyPasswordlessUserVerificationRequirement = webAuthnPolicyPasswordlessUserVerificationRequirement.(string)
-		}
+	if webAuthnPolicyPasswordlessAttestationConveyancePreference, ok := webAuthnPasswordlessPolicy["attestation_conveyance_preference"]; ok {
+		realm.WebAuthnPolicyPasswordlessAttestationConveyancePreference = webAuthnPolicyPasswordlessAttestationConveyancePreference.(string)
 	}
 
-	return realm, nil
-}
+	if webAuthnPolicyPasswordlessAuthenticatorAttachment, ok := webAuthnPasswordlessPolicy["authenticator_attachment"]; ok {
+		realm.WebAuthnPolicyPasswordlessAuthenticatorAttachment = webAuthnPolicyPasswordlessAuthenticatorAttachment.(string)
+	}
 
-func setDefaultSecuritySettingHeaders(realm *keycloak.Realm) {
-	realm.BrowserSecurityHeaders = keycloak.BrowserSecurityHeaders{
-		ContentSecurityPolicy:           "frame-src 'self'; frame-ancestors 'self'; object-src 'none';",
-		ContentSecurityPolicyReportOnly: "",
-		StrictTransportSecurity:         "max-age=31536000; includeSubDomains",
-		XContentTypeOptions:             "nosniff",
-		XFrameOptions:                   "SAMEORIGIN",
-		XRobotsTag:                      "none",
-		XXSSProtection:                  "1; mode=block",
-		ReferrerPolicy:                  "no-referrer",
+	if webAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister, ok := webAuthnPasswordlessPolicy["avoid_same_authenticator_register"]; ok {
+		realm.WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister = webAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister.(bool)
 	}
-}
 
-func setDefaultSecuritySettingsBruteForceDetection(realm *keycloak.Realm) {
-	realm.BruteForceProtected = false
-	realm.PermanentLockout = false
-	realm.FailureFactor = 30
-	realm.WaitIncrementSeconds = 60
-	realm.QuickLoginCheckMilliSeconds = 1000
-	realm.MinimumQuickLoginWaitSeconds = 60
-	realm.MaxFailureWaitSeconds = 900
-	realm.MaxDeltaTimeSeconds = 43200
-}
+	if webAuthnPolicyPasswordlessCreateTimeout, ok := webAuthnPasswordlessPolicy["create_timeout"]; ok {
+		realm.WebAuthnPolicyPasswordlessCreateTimeout = webAuthnPolicyPasswordlessCreateTimeout.(int)
+	}
 
-func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
-	data.SetId(realm.Realm)
+	if webAuthnPolicyPasswordlessRequireResidentKey, ok := webAuthnPasswordlessPolicy["require_resident_key"]; ok {
+		realm.WebAuthnPolicyPasswordlessRequireResidentKey = webAuthnPolicyPasswordlessRequireResidentKey.(string)
+	}
 
-	data.Set("realm", realm.Realm)
-	data.Set("internal_id", realm.Id)
-	data.Set("enabled", realm.Enabled)
-	data.Set("display_name", realm.DisplayName)
-	data.Set("display_name_html", realm.DisplayNameHtml)
-	data.Set("user_managed_access", realm.UserManagedAccess)
+	if webAuthnPolicyPasswordlessRpEntityName, ok := webAuthnPasswordlessPolicy["relying_party_entity_name"]; ok {
+		realm.WebAuthnPolicyPasswordlessRpEntityName = webAuthnPolicyPasswordlessRpEntityName.(string)
+	}
 
-	// Login Config
-	data.Set("registration_allowed", realm.RegistrationAllowed)
-	data.Set("registration_email_as_username", realm.RegistrationEmailAsUsername)
-	data.Set("edit_username_allowed", realm.EditUsernameAllowed)
-	data.Set("reset_password_allowed", realm.ResetPasswordAllowed)
-	data.Set("remember_me", realm.RememberMe)
-	data.Set("verify_email", realm.VerifyEmail)
-	data.Set("login_with_email_allowed", realm.LoginWithEmailAllowed)
-	data.Set("duplicate_emails_allowed", realm.DuplicateEmailsAllowed)
-	data.Set("ssl_required", realm.SslRequired)
+	if webAuthnPolicyPasswordlessRpId, ok := webAuthnPasswordlessPolicy["relying_party_id"]; ok {
+		realm.WebAuthnPolicyPasswordlessRpId = webAuthnPolicyPasswordlessRpId.(string)
+	}
 
-	// Smtp Config
+	realm.WebAuthnPolicyPasswordlessSignatureAlgorithms = interfaceSliceToStringSlice(webAuthnPasswordlessPolicy["signature_algorithms"].(*schema.Set).List())
 
-	if (keycloak.SmtpServer{}) == realm.SmtpServer {
-		data.Set("smtp_server", nil)
-	} else {
-		smtpSettings := make(map[string]interface{})
+	if webAuthnPolicyPasswordlessUserVerificationRequirement, ok := webAuthnPasswordlessPolicy["user_verification_requirement"]; ok {
+		realm.WebAuthnPolicyPasswordlessUserVerificationRequirement = webAuthnPolicyPasswordlessUserVerificationRequirement.(string)
+	}
+}
 
-		smtpSettings["starttls"] = realm.SmtpServer.StartTls
-		smtpSettings["port"] = realm.SmtpServer.Port
-		smtpSettings["host"] = realm.SmtpServer.Host
-		smtpSettings["reply_to"] = realm.SmtpServer.ReplyTo
-		smtpSettings["reply_to_display_name"] = realm.SmtpServer.ReplyToDisplayName
-		smtpSettings["from"] = realm.SmtpServer.From
-		smtpSettings["from_display_name"] = realm.SmtpServer.FromDisplayName
-		smtpSettings["envelope_from"] = realm.SmtpServer.EnvelopeFrom
-		smtpSettings["ssl"] = realm.SmtpServer.Ssl
+func getRealmSmtpServerSettings(smtpServer keycloak.SmtpServer) map[string]interface{} {
+	smtpSettings := make(map[string]interface{})
 
-		if realm.SmtpServer.Auth {
-			auth := make(map[string]interface{})
+	smtpSettings["starttls"] = smtpServer.StartTls
+	smtpSettings["port"] = smtpServer.Port
+	smtpSettings["host"] = smtpServer.Host
+	smtpSettings["reply_to"] = smtpServer.ReplyTo
+	smtpSettings["reply_to_display_name"] = smtpServer.ReplyToDisplayName
+	smtpSettings["from"] = smtpServer.From
+	smtpSettings["from_display_name"] = smtpServer.FromDisplayName
+	smtpSettings["envelope_from"] = smtpServer.EnvelopeFrom
+	smtpSettings["ssl"] = smtpServer.Ssl
 
-			auth["username"] = realm.SmtpServer.User
-			auth["password"] = realm.SmtpServer.Password
+	if smtpServer.Auth {
+		auth := make(map[string]interface{})
 
-			smtpSettings["auth"] = []interface{}{auth}
-		}
+		auth["username"] = smtpServer.User
+		auth["password"] = smtpServer.Password
 
-		data.Set("smtp_server", []interface{}{smtpSettings})
+		smtpSettings["auth"] = []interface{}{auth}
 	}
 
-	// Themes
-	data.Set("login_theme", realm.LoginTheme)
-	data.Set("account_theme", realm.AccountTheme)
-	data.Set("admin_theme", realm.AdminTheme)
-	data.Set("email_theme", realm.EmailTheme)
+	return smtpSettings
+}
 
-	// Tokens
+func setRealmTokenSettingsData(data *schema.ResourceData, realm *keycloak.Realm) {
 	data.Set("default_signature_algorithm", realm.DefaultSignatureAlgorithm)
 	data.Set("revoke_refresh_token", realm.RevokeRefreshToken)
 	data.Set("refresh_token_max_reuse", realm.RefreshTokenMaxReuse)
@@ -1248,48 +1560,9 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	data.Set("action_token_generated_by_admin_lifespan", getDurationStringFromSeconds(realm.ActionTokenGeneratedByAdminLifespan))
 	data.Set("oauth2_device_code_lifespan", getDurationStringFromSeconds(realm.Oauth2DeviceCodeLifespan))
 	data.Set("oauth2_device_polling_interval", realm.Oauth2DevicePollingInterval)
+}
 
-	//internationalization
-	if realm.InternationalizationEnabled {
-		internationalizationSettings := make(map[string]interface{})
-		internationalizationSettings["supported_locales"] = realm.SupportLocales
-		internationalizationSettings["default_locale"] = realm.DefaultLocale
-		data.Set("internationalization", []interface{}{internationalizationSettings})
-	} else {
-		data.Set("internationalization", nil)
-	}
-
-	if v, ok := data.GetOk("security_defenses"); ok {
-		oldHeadersConfig := v.([]interface{})[0].(map[string]interface{})["headers"].([]interface{})
-		if len(oldHeadersConfig) == 0 && !realm.BruteForceProtected {
-			data.Set("security_defenses", nil)
-		} else if len(oldHeadersConfig) == 1 && realm.BruteForceProtected {
-			securityDefensesSettings := make(map[string]interface{})
-			securityDefensesSettings["headers"] = []interface{}{getHeaderSettings(realm)}
-			securityDefensesSettings["brute_force_detection"] = []interface{}{getBruteForceDetectionSettings(realm)}
-			data.Set("security_defenses", []interface{}{securityDefensesSettings})
-		} else if len(oldHeadersConfig) == 1 {
-			securityDefensesSettings := make(map[string]interface{})
-			securityDefensesSettings["headers"] = []interface{}{getHeaderSettings(realm)}
-			data.Set("security_defenses", []interface{}{securityDefensesSettings})
-		} else if realm.BruteForceProtected {
-			securityDefensesSettings := make(map[string]interface{})
-			securityDefensesSettings["brute_force_detection"] = []interface{}{getBruteForceDetectionSettings(realm)}
-			data.Set("security_defenses", []interface{}{securityDefensesSettings})
-		}
-	}
-
-	data.Set("password_policy", realm.PasswordPolicy)
-
-	//Flow Bindings
-	data.Set("browser_flow", realm.BrowserFlow)
-	data.Set("registration_flow", realm.RegistrationFlow)
-	data.Set("direct_grant_flow", realm.DirectGrantFlow)
-	data.Set("reset_credentials_flow", realm.ResetCredentialsFlow)
-	data.Set("client_authentication_flow", realm.ClientAuthenticationFlow)
-	data.Set("docker_authentication_flow", realm.DockerAuthenticationFlow)
-
-	//WebAuthn
+func getRealmWebAuthnPolicySettings(realm *keycloak.Realm) map[string]interface{} {
 	webAuthnPolicy := make(map[string]interface{})
 	webAuthnPolicy["acceptable_aaguids"] = realm.WebAuthnPolicyAcceptableAaguids
 	webAuthnPolicy["attestation_conveyance_preference"] = realm.WebAuthnPolicyAttestationConveyancePreference
@@ -1301,9 +1574,41 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	webAuthnPolicy["relying_party_id"] = realm.WebAuthnPolicyRpId
 	webAuthnPolicy["signature_algorithms"] = realm.WebAuthnPolicySignatureAlgorithms
 	webAuthnPolicy["user_verification_requirement"] = realm.WebAuthnPolicyUserVerificationRequirement
-	data.Set("web_authn_policy", []interface{}{webAuthnPolicy})
 
-	//OTP Policy
+	return webAuthnPolicy
+}
+
+func setRealmCibaPolicyFromSettings(realm *keycloak.Realm, cibaPolicy map[string]interface{}) error {
+	expiresIn, err := getSecondsFromDurationString(cibaPolicy["expires_in"].(string))
+	if err != nil {
+		return err
+	}
+
+	realm.Attributes[keycloak.RealmAttributeCibaBackchannelTokenDeliveryMode] = cibaPolicy["backchannel_token_delivery_mode"].(string)
+	realm.Attributes[keycloak.RealmAttributeCibaExpiresIn] = strconv.Itoa(expiresIn)
+	realm.Attributes[keycloak.RealmAttributeCibaInterval] = strconv.Itoa(cibaPolicy["interval"].(int))
+	realm.Attributes[keycloak.RealmAttributeCibaAuthRequestedUserHint] = cibaPolicy["auth_requested_user_hint"].(string)
+
+	return nil
+}
+
+func getRealmCibaPolicySettings(realm *keycloak.Realm) map[string]interface{} {
+	cibaPolicy := make(map[string]interface{})
+	cibaPolicy["backchannel_token_delivery_mode"] = realm.Attributes[keycloak.RealmAttributeCibaBackchannelTokenDeliveryMode]
+	cibaPolicy["auth_requested_user_hint"] = realm.Attributes[keycloak.RealmAttributeCibaAuthRequestedUserHint]
+
+	if expiresIn, err := strconv.Atoi(fmt.Sprint(realm.Attributes[keycloak.RealmAttributeCibaExpiresIn])); err == nil {
+		cibaPolicy["expires_in"] = getDurationStringFromSeconds(expiresIn)
+	}
+
+	if interval, err := strconv.Atoi(fmt.Sprint(realm.Attributes[keycloak.RealmAttributeCibaInterval])); err == nil {
+		cibaPolicy["interval"] = interval
+	}
+
+	return cibaPolicy
+}
+
+func getRealmOtpPolicySettings(realm *keycloak.Realm) map[string]interface{} {
 	otpPolicy := make(map[string]interface{})
 	otpPolicy["type"] = realm.OTPPolicyType
 	otpPolicy["algorithm"] = realm.OTPPolicyAlgorithm
@@ -1311,9 +1616,11 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	otpPolicy["initial_counter"] = realm.OTPPolicyInitialCounter
 	otpPolicy["look_ahead_window"] = realm.OTPPolicyLookAheadWindow
 	otpPolicy["period"] = realm.OTPPolicyPeriod
-	data.Set("otp_policy", []interface{}{otpPolicy})
 
-	//WebAuthn Passwordless
+	return otpPolicy
+}
+
+func getRealmWebAuthnPasswordlessPolicySettings(realm *keycloak.Realm) map[string]interface{} {
 	webAuthnPasswordlessPolicy := make(map[string]interface{})
 	webAuthnPasswordlessPolicy["acceptable_aaguids"] = realm.WebAuthnPolicyPasswordlessAcceptableAaguids
 	webAuthnPasswordlessPolicy["attestation_conveyance_preference"] = realm.WebAuthnPolicyPasswordlessAttestationConveyancePreference
@@ -1325,45 +1632,8 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	webAuthnPasswordlessPolicy["relying_party_id"] = realm.WebAuthnPolicyPasswordlessRpId
 	webAuthnPasswordlessPolicy["signature_algorithms"] = realm.WebAuthnPolicyPasswordlessSignatureAlgorithms
 	webAuthnPasswordlessPolicy["user_verification_requirement"] = realm.WebAuthnPolicyPasswordlessUserVerificationRequirement
-	data.Set("web_authn_passwordless_policy", []interface{}{webAuthnPasswordlessPolicy})
-
-	attributes := map[string]interface{}{}
-	if v, ok := data.GetOk("attributes"); ok {
-		for key := range v.(map[string]interface{}) {
-			attributes[key] = realm.Attributes[key]
-			//We are only interested in attributes managed in terraform (Keycloak returns a lot of doubles values in the attributes...)
-		}
-	}
-	data.Set("attributes", attributes)
-
-	// default and optional client scope mappings
-	data.Set("default_default_client_scopes", realm.DefaultDefaultClientScopes)
-	data.Set("default_optional_client_scopes", realm.DefaultOptionalClientScopes)
-}
-
-func getBruteForceDetectionSettings(realm *keycloak.Realm) map[string]interface{} {
-	bruteForceDetectionSettings := make(map[string]interface{})
-	bruteForceDetectionSettings["permanent_lockout"] = realm.PermanentLockout
-	bruteForceDetectionSettings["max_login_failures"] = realm.FailureFactor
-	bruteForceDetectionSettings["wait_increment_seconds"] = realm.WaitIncrementSeconds
-	bruteForceDetectionSettings["quick_login_check_milli_seconds"] = realm.QuickLoginCheckMilliSeconds
-	bruteForceDetectionSettings["minimum_quick_login_wait_seconds"] = realm.MinimumQuickLoginWaitSeconds
-	bruteForceDetectionSettings["max_failure_wait_seconds"] = realm.MaxFailureWaitSeconds
-	bruteForceDetectionSettings["failure_reset_time_seconds"] = realm.MaxDeltaTimeSeconds
-	return bruteForceDetectionSettings
-}
 
-func getHeaderSettings(realm *keycloak.Realm) map[string]interface{} {
-	headersSettings := make(map[string]interface{})
-	headersSettings["content_security_policy"] = realm.BrowserSecurityHeaders.ContentSecurityPolicy
-	headersSettings["content_security_policy_report_only"] = realm.BrowserSecurityHeaders.ContentSecurityPolicyReportOnly
-	headersSettings["strict_transport_security"] = realm.BrowserSecurityHeaders.StrictTransportSecurity
-	headersSettings["x_content_type_options"] = realm.BrowserSecurityHeaders.XContentTypeOptions
-	headersSettings["x_frame_options"] = realm.BrowserSecurityHeaders.XFrameOptions
-	headersSettings["x_robots_tag"] = realm.BrowserSecurityHeaders.XRobotsTag
-	headersSettings["x_xss_protection"] = realm.BrowserSecurityHeaders.XXSSProtection
-	headersSettings["referrer_policy"] = realm.BrowserSecurityHeaders.ReferrerPolicy
-	return headersSettings
+	return webAuthnPasswordlessPolicy
 }
 
 func resourceKeycloakRealmCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1379,6 +1649,14 @@ func resourceKeycloakRealmCreate(ctx context.Context, data *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	// the realm does not exist yet, so its SMTP settings are verified through the realm the provider is authenticated against
+	if data.Get("smtp_server.0.verify_on_apply").(bool) {
+		err = keycloakClient.TestSmtpConnection(ctx, realm.SmtpServer, data.Get("smtp_server.0.verify_recipient").(string))
+		if err != nil {
+			return smtpServerVerificationError(err)
+		}
+	}
+
 	err = keycloakClient.NewRealm(ctx, realm)
 	if err != nil {
 		return diag.FromErr(err)
@@ -1402,14 +1680,27 @@ func resourceKeycloakRealmRead(ctx context.Context, data *schema.ResourceData, m
 		realm.SmtpServer.Password = smtpPassword
 	}
 
+	// the smtp server is only read when it is managed by this resource, or when the realm is imported, so that it can be
+	// managed by keycloak_realm_smtp_server instead
+	_, smtpServerIsManaged := data.GetOk("smtp_server")
+	importing := data.Get("realm").(string) == ""
+
 	setRealmData(data, realm)
 
+	if !smtpServerIsManaged && !importing {
+		data.Set("smtp_server", nil)
+	}
+
 	return nil
 }
 
 func resourceKeycloakRealmUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := realmMutexKey(data.Get("realm").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realm, err := getRealmFromData(data)
 	if err != nil {
 		return diag.FromErr(err)
@@ -1420,17 +1711,38 @@ func resourceKeycloakRealmUpdate(ctx context.Context, data *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.UpdateRealm(ctx, realm)
+	if data.Get("smtp_server.0.verify_on_apply").(bool) && data.HasChange("smtp_server") {
+		err = keycloakClient.TestRealmSmtpConnection(ctx, realm.Realm, realm.SmtpServer, data.Get("smtp_server.0.verify_recipient").(string))
+		if err != nil {
+			return smtpServerVerificationError(err)
+		}
+	}
+
+	var removedAttributes []string
+	if data.HasChange("attributes") {
+		oldAttributes, newAttributes := data.GetChange("attributes")
+		for key := range oldAttributes.(map[string]interface{}) {
+			if _, ok := newAttributes.(map[string]interface{})[key]; !ok {
+				removedAttributes = append(removedAttributes, key)
+			}
+		}
+	}
+
+	err = keycloakClient.UpdateRealmExcludingFields(ctx, realm, getRealmUnmanagedFields(data), removedAttributes...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	setRealmData(data, realm)
 
-	return nil
+	return resourceKeycloakRealmRead(ctx, data, meta)
 }
 
 func resourceKeycloakRealmDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(data, "realm"); diags.HasError() {
+		return diags
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	return diag.FromErr(keycloakClient.DeleteRealm(ctx, data.Id()))
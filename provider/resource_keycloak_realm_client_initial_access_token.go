@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// Initial access tokens cannot be updated, and the token itself is only returned by Keycloak when it is created, so
+// every argument forces a new token.
+func resourceKeycloakRealmClientInitialAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmClientInitialAccessTokenCreate,
+		ReadContext:   resourceKeycloakRealmClientInitialAccessTokenRead,
+		DeleteContext: resourceKeycloakRealmClientInitialAccessTokenDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"expiration": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				ValidateFunc:     validateDurationString,
+				Description:      "Duration after which the token expires, such as 24h. A duration of 0s means that the token does not expire.",
+			},
+			"max_registrations": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of clients that can be registered with the token.",
+			},
+			"remaining_registrations": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func setRealmClientInitialAccessTokenData(data *schema.ResourceData, token *keycloak.ClientInitialAccessToken) {
+	data.Set("max_registrations", token.Count)
+	data.Set("remaining_registrations", token.RemainingCount)
+	data.Set("timestamp", token.Timestamp)
+}
+
+func resourceKeycloakRealmClientInitialAccessTokenCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	expiration, err := getSecondsFromDurationString(data.Get("expiration").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	token := &keycloak.ClientInitialAccessToken{
+		RealmId:    data.Get("realm_id").(string),
+		Expiration: expiration,
+		Count:      data.Get("max_registrations").(int),
+	}
+
+	err = keycloakClient.NewClientInitialAccessToken(ctx, token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(token.Id)
+	data.Set("token", token.Token)
+
+	return resourceKeycloakRealmClientInitialAccessTokenRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientInitialAccessTokenRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// expired and used up tokens are removed by keycloak, in which case a new token is created on the next apply
+	token, err := keycloakClient.GetClientInitialAccessToken(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmClientInitialAccessTokenData(data, token)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientInitialAccessTokenDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DeleteClientInitialAccessToken(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmClientInitialAccessToken_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_client_initial_access_token.token"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientInitialAccessTokenDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientInitialAccessToken_basic(realmName, "24h", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientInitialAccessTokenExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					resource.TestCheckResourceAttrSet(resourceName, "timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "max_registrations", "2"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_registrations", "2"),
+				),
+			},
+			{
+				Config:   testKeycloakRealmClientInitialAccessToken_basic(realmName, "1440m", 2),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakRealmClientInitialAccessToken_basic(realmName, "48h", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientInitialAccessTokenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_registrations", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientInitialAccessToken_invalidExpiration(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientInitialAccessTokenDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientInitialAccessToken_basic(realmName, "1 day", 1),
+				ExpectError: regexp.MustCompile("expected expiration to be a duration string"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientInitialAccessTokenExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetClientInitialAccessToken(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting client initial access token with id %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmClientInitialAccessTokenDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_client_initial_access_token" {
+				continue
+			}
+
+			token, _ := keycloakClient.GetClientInitialAccessToken(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if token != nil {
+				return fmt.Errorf("client initial access token with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmClientInitialAccessToken_basic(realm, expiration string, maxRegistrations int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_initial_access_token" "token" {
+	realm_id          = keycloak_realm.realm.id
+	expiration        = "%s"
+	max_registrations = %d
+}
+	`, realm, expiration, maxRegistrations)
+}
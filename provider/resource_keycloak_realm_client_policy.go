@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmClientPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmClientPolicyCreate,
+		ReadContext:   resourceKeycloakRealmClientPolicyRead,
+		DeleteContext: resourceKeycloakRealmClientPolicyDelete,
+		UpdateContext: resourceKeycloakRealmClientPolicyUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmClientPolicyImport,
+		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffValidateServerInfoBlocks("condition", "condition", validateProviderInstalled("client policy condition", "client-policy-condition")),
+			customizeDiffValidateClientPolicyConditions,
+		),
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"condition": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The conditions that determine which clients the policy applies to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"configuration": {
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "The configuration of the condition. Values that are lists or objects must be JSON encoded.",
+						},
+					},
+				},
+			},
+			"profiles": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The names of the client profiles, realm or global, that are applied to the clients matching the conditions.",
+			},
+		},
+	}
+}
+
+func getRealmClientPolicyFromData(data *schema.ResourceData) *keycloak.RealmClientPolicy {
+	conditions := make([]*keycloak.RealmClientPolicyCondition, 0)
+	for _, v := range data.Get("condition").([]interface{}) {
+		condition := v.(map[string]interface{})
+
+		conditions = append(conditions, &keycloak.RealmClientPolicyCondition{
+			Condition:     condition["condition"].(string),
+			Configuration: getClientPolicyConfigurationFromData(condition["configuration"].(map[string]interface{})),
+		})
+	}
+
+	profiles := interfaceSliceToStringSlice(data.Get("profiles").([]interface{}))
+	if profiles == nil {
+		profiles = []string{}
+	}
+
+	return &keycloak.RealmClientPolicy{
+		Name:        data.Get("name").(string),
+		Description: data.Get("description").(string),
+		Enabled:     data.Get("enabled").(bool),
+		Conditions:  conditions,
+		Profiles:    profiles,
+	}
+}
+
+func setRealmClientPolicyData(data *schema.ResourceData, policy *keycloak.RealmClientPolicy) {
+	var conditions []interface{}
+	for _, condition := range policy.Conditions {
+		conditions = append(conditions, map[string]interface{}{
+			"condition":     condition.Condition,
+			"configuration": getClientPolicyConfigurationData(condition.Configuration),
+		})
+	}
+
+	data.Set("name", policy.Name)
+	data.Set("description", policy.Description)
+	data.Set("enabled", policy.Enabled)
+	data.Set("condition", conditions)
+	data.Set("profiles", policy.Profiles)
+}
+
+func resourceKeycloakRealmClientPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	policy := getRealmClientPolicyFromData(data)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	policies, err := keycloakClient.GetRealmClientPolicies(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if policies.IsGlobal(policy.Name) {
+		return diag.Errorf("client policy %s is a global client policy, which is built into Keycloak and cannot be managed", policy.Name)
+	}
+
+	for _, existingPolicy := range policies.Policies {
+		if existingPolicy.Name == policy.Name {
+			return diag.Errorf("client policy %s already exists in realm %s, it can be imported instead", policy.Name, realmId)
+		}
+	}
+
+	err = keycloakClient.UpdateRealmClientPolicies(ctx, realmId, append(policies.Policies, policy))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, policy.Name))
+
+	return resourceKeycloakRealmClientPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy, err := keycloakClient.GetRealmClientPolicy(ctx, data.Get("realm_id").(string), data.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmClientPolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	policy := getRealmClientPolicyFromData(data)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	policies, err := keycloakClient.GetRealmClientPolicies(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	found := false
+	for i, existingPolicy := range policies.Policies {
+		if existingPolicy.Name == policy.Name {
+			policies.Policies[i] = policy
+			found = true
+		}
+	}
+
+	if !found {
+		policies.Policies = append(policies.Policies, policy)
+	}
+
+	err = keycloakClient.UpdateRealmClientPolicies(ctx, realmId, policies.Policies)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmClientPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	policies, err := keycloakClient.GetRealmClientPolicies(ctx, realmId)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	remainingPolicies := make([]*keycloak.RealmClientPolicy, 0)
+	for _, policy := range policies.Policies {
+		if policy.Name != name {
+			remainingPolicies = append(remainingPolicies, policy)
+		}
+	}
+
+	return diag.FromErr(keycloakClient.UpdateRealmClientPolicies(ctx, realmId, remainingPolicies))
+}
+
+func resourceKeycloakRealmClientPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{policyName}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := keycloakClient.GetRealmClientPolicies(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	if policies.IsGlobal(parts[1]) {
+		return nil, fmt.Errorf("client policy %s is a global client policy, which is built into Keycloak and cannot be managed", parts[1])
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("name", parts[1])
+	d.SetId(fmt.Sprintf("%s/%s", realmId, parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmClientPolicyProfile() *schema.Resource {
+	executorSchema := map[string]*schema.Schema{
+		"executor": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"configuration": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "The configuration of an executor that has no typed block. Values that are lists or objects must be JSON encoded.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmClientPolicyProfileCreate,
+		ReadContext:   resourceKeycloakRealmClientPolicyProfileRead,
+		DeleteContext: resourceKeycloakRealmClientPolicyProfileDelete,
+		UpdateContext: resourceKeycloakRealmClientPolicyProfileUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmClientPolicyProfileImport,
+		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffValidateServerInfoBlocks("executor", "executor", validateProviderInstalled("client policy executor", "client-policy-executor")),
+			customizeDiffValidateClientPolicyExecutors,
+		),
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"executor": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The executors of the profile, in the order they are executed.",
+				Elem: &schema.Resource{
+					Schema: mergeSchemas(executorSchema, clientPolicyExecutorBlocksSchema()),
+				},
+			},
+		},
+	}
+}
+
+// Client policy executors and conditions accept typed configuration values, such as lists of client authenticators.
+// Values that are JSON lists or objects are decoded before they are sent to Keycloak, and every value that is not a
+// string is JSON encoded when it is read back.
+func getClientPolicyConfigurationFromData(data map[string]interface{}) map[string]interface{} {
+	configuration := make(map[string]interface{})
+	for key, value := range data {
+		stringValue := value.(string)
+
+		var typedValue interface{}
+		if (strings.HasPrefix(stringValue, "[") || strings.HasPrefix(stringValue, "{")) && json.Unmarshal([]byte(stringValue), &typedValue) == nil {
+			configuration[key] = typedValue
+		} else {
+			configuration[key] = stringValue
+		}
+	}
+
+	return configuration
+}
+
+func getClientPolicyConfigurationData(configuration map[string]interface{}) map[string]interface{} {
+	data := make(map[string]interface{})
+	for key, value := range configuration {
+		if stringValue, ok := value.(string); ok {
+			data[key] = stringValue
+		} else {
+			encodedValue, _ := json.Marshal(value)
+			data[key] = string(encodedValue)
+		}
+	}
+
+	return data
+}
+
+func getRealmClientPolicyProfileFromData(data *schema.ResourceData) *keycloak.RealmClientPolicyProfile {
+	executors := make([]*keycloak.RealmClientPolicyProfileExecutor, 0)
+	for _, v := range data.Get("executor").([]interface{}) {
+		executor := v.(map[string]interface{})
+
+		executors = append(executors, &keycloak.RealmClientPolicyProfileExecutor{
+			Executor:      executor["executor"].(string),
+			Configuration: getClientPolicyExecutorConfigurationFromData(executor),
+		})
+	}
+
+	return &keycloak.RealmClientPolicyProfile{
+		Name:        data.Get("name").(string),
+		Description: data.Get("description").(string),
+		Executors:   executors,
+	}
+}
+
+func setRealmClientPolicyProfileData(data *schema.ResourceData, profile *keycloak.RealmClientPolicyProfile) {
+	var executors []interface{}
+	for _, executor := range profile.Executors {
+		executorData := map[string]interface{}{
+			"executor": executor.Executor,
+		}
+		setClientPolicyExecutorConfigurationData(executorData, executor.Executor, executor.Configuration)
+
+		executors = append(executors, executorData)
+	}
+
+	data.Set("name", profile.Name)
+	data.Set("description", profile.Description)
+	data.Set("executor", executors)
+}
+
+func resourceKeycloakRealmClientPolicyProfileCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	profile := getRealmClientPolicyProfileFromData(data)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	profiles, err := keycloakClient.GetRealmClientPolicyProfiles(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if profiles.IsGlobal(profile.Name) {
+		return diag.Errorf("client profile %s is a global client profile, which is built into Keycloak and cannot be managed", profile.Name)
+	}
+
+	for _, existingProfile := range profiles.Profiles {
+		if existingProfile.Name == profile.Name {
+			return diag.Errorf("client profile %s already exists in realm %s, it can be imported instead", profile.Name, realmId)
+		}
+	}
+
+	err = keycloakClient.UpdateRealmClientPolicyProfiles(ctx, realmId, append(profiles.Profiles, profile))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, profile.Name))
+
+	return resourceKeycloakRealmClientPolicyProfileRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyProfileRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	profile, err := keycloakClient.GetRealmClientPolicyProfile(ctx, data.Get("realm_id").(string), data.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmClientPolicyProfileData(data, profile)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientPolicyProfileUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	profile := getRealmClientPolicyProfileFromData(data)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	profiles, err := keycloakClient.GetRealmClientPolicyProfiles(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	found := false
+	for i, existingProfile := range profiles.Profiles {
+		if existingProfile.Name == profile.Name {
+			profiles.Profiles[i] = profile
+			found = true
+		}
+	}
+
+	if !found {
+		profiles.Profiles = append(profiles.Profiles, profile)
+	}
+
+	err = keycloakClient.UpdateRealmClientPolicyProfiles(ctx, realmId, profiles.Profiles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmClientPolicyProfileRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyProfileDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	profiles, err := keycloakClient.GetRealmClientPolicyProfiles(ctx, realmId)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	remainingProfiles := make([]*keycloak.RealmClientPolicyProfile, 0)
+	for _, profile := range profiles.Profiles {
+		if profile.Name != name {
+			remainingProfiles = append(remainingProfiles, profile)
+		}
+	}
+
+	return diag.FromErr(keycloakClient.UpdateRealmClientPolicyProfiles(ctx, realmId, remainingProfiles))
+}
+
+func resourceKeycloakRealmClientPolicyProfileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{profileName}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := keycloakClient.GetRealmClientPolicyProfiles(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	if profiles.IsGlobal(parts[1]) {
+		return nil, fmt.Errorf("client profile %s is a global client profile, which is built into Keycloak and cannot be managed", parts[1])
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("name", parts[1])
+	d.SetId(fmt.Sprintf("%s/%s", realmId, parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmClientPolicyProfile_globalProfileIsReadOnly(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_global(realmName),
+				ExpectError: regexp.MustCompile("is a global client profile"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientPolicyProfile_invalidExecutor(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_invalidExecutor(realmName),
+				ExpectError: regexp.MustCompile("client policy executor \"not-an-executor\" does not exist on the server"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientPolicyProfile_invalidConfiguration(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_executor(realmName, "secure-session", `configuration = { values = "[\"a\", " }`),
+				ExpectError: regexp.MustCompile("configuration value values of executor secure-session is not valid JSON"),
+			},
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_executor(realmName, "secure-session", "pkce_enforcer { auto_configure = true }"),
+				ExpectError: regexp.MustCompile("the pkce_enforcer block can only be used with the pkce-enforcer executor"),
+			},
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_executor(realmName, "pkce-enforcer", `configuration = { auto-configure = "true" }`),
+				ExpectError: regexp.MustCompile("the configuration of the pkce-enforcer executor must be set with the pkce_enforcer block"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientPolicyProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetRealmClientPolicyProfile(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["name"])
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakRealmClientPolicyProfileExecutorConfiguration(resourceName string, index int, key string, value interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		profile, err := keycloakClient.GetRealmClientPolicyProfile(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["name"])
+		if err != nil {
+			return err
+		}
+
+		if len(profile.Executors) <= index {
+			return fmt.Errorf("expected client profile %s to have at least %d executors, but it has %d", profile.Name, index+1, len(profile.Executors))
+		}
+
+		if profile.Executors[index].Configuration[key] != value {
+			return fmt.Errorf("expected executor %s to have configuration %s set to %v, but was %v", profile.Executors[index].Executor, key, value, profile.Executors[index].Configuration[key])
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmClientPolicyProfile_global(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id = keycloak_realm.realm.id
+	name     = "fapi-1-baseline"
+}
+	`, realm)
+}
+
+func testKeycloakRealmClientPolicyProfile_invalidExecutor(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id = keycloak_realm.realm.id
+	name     = "tf-acc-profile"
+
+	executor {
+		executor = "not-an-executor"
+	}
+}
+	`, realm)
+}
+
+func testKeycloakRealmClientPolicyProfile_executor(realm, executor, configuration string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id = keycloak_realm.realm.id
+	name     = "tf-acc-profile"
+
+	executor {
+		executor = "%s"
+
+		%s
+	}
+}
+	`, realm, executor, configuration)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmClientPolicy_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicy_basic(realmName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyProfileExists("keycloak_realm_client_policy_profile.profile"),
+					testAccCheckKeycloakRealmClientPolicyExists("keycloak_realm_client_policy.policy"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.0.secure_client_authenticator.0.allowed_client_authenticators.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.0.secure_client_authenticator.0.default_client_authenticator", "client-jwt"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.1.pkce_enforcer.0.auto_configure", "true"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.2.executor", "secure-session"),
+					testAccCheckKeycloakRealmClientPolicyProfileExecutorConfiguration("keycloak_realm_client_policy_profile.profile", 1, "auto-configure", true),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy.policy", "profiles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_client_policy_profile.profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/tf-acc-profile",
+			},
+			{
+				ResourceName:      "keycloak_realm_client_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/tf-acc-policy",
+			},
+			{
+				Config: testKeycloakRealmClientPolicy_basic(realmName, false),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_client_policy.policy", "enabled", "false"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetRealmClientPolicy(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["name"])
+
+		return err
+	}
+}
+
+func testKeycloakRealmClientPolicy_basic(realm string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id    = keycloak_realm.realm.id
+	name        = "tf-acc-profile"
+	description = "Confidential clients"
+
+	executor {
+		executor = "secure-client-authenticator"
+
+		secure_client_authenticator {
+			allowed_client_authenticators = ["client-jwt", "client-secret-jwt"]
+			default_client_authenticator  = "client-jwt"
+		}
+	}
+
+	executor {
+		executor = "pkce-enforcer"
+
+		pkce_enforcer {
+			auto_configure = true
+		}
+	}
+
+	executor {
+		executor = "secure-session"
+	}
+}
+
+resource "keycloak_realm_client_policy" "policy" {
+	realm_id = keycloak_realm.realm.id
+	name     = "tf-acc-policy"
+	enabled  = %t
+
+	condition {
+		condition = "client-access-type"
+		configuration = {
+			type = jsonencode(["confidential"])
+		}
+	}
+
+	profiles = [
+		keycloak_realm_client_policy_profile.profile.name,
+		"fapi-1-baseline",
+	]
+}
+	`, realm, enabled)
+}
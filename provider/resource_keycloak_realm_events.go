@@ -13,6 +13,10 @@ func resourceKeycloakRealmEvents() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmEventsRead,
 		DeleteContext: resourceKeycloakRealmEventsDelete,
 		UpdateContext: resourceKeycloakRealmEventsUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		CustomizeDiff: customizeDiffValidateServerInfo("events_listeners", validateProviderInstalled("event listener", "eventsListener")),
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -124,6 +128,11 @@ func resourceKeycloakRealmEventsRead(ctx context.Context, data *schema.ResourceD
 
 func resourceKeycloakRealmEventsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	mutexKey := realmMutexKey(data.Get("realm_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 
 	// The realm events config cannot be deleted, so instead we set it back to its "zero" values.
@@ -140,6 +149,10 @@ func resourceKeycloakRealmEventsDelete(ctx context.Context, data *schema.Resourc
 func resourceKeycloakRealmEventsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := realmMutexKey(data.Get("realm_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	realmEventsConfig := getRealmEventsConfigFromData(data)
 
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"regexp"
 	"testing"
 )
 
@@ -20,6 +21,13 @@ func TestAccKeycloakRealmEvents_basic(t *testing.T) {
 				Config: testKeycloakRealmEvents_basic(realmName),
 				Check:  testAccCheckKeycloakRealmEventsExists("keycloak_realm_events.realm_events"),
 			},
+			{
+				ResourceName:            "keycloak_realm_events.realm_events",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           realmName,
+				ImportStateVerifyIgnore: []string{"enabled_event_types"},
+			},
 		},
 	})
 }
@@ -195,6 +203,26 @@ func TestAccKeycloakRealmEvents_unsetEnabledEventTypes(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealmEvents_invalidEventsListener(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	realmEventsConfig := &keycloak.RealmEventsConfig{
+		EventsListeners: []string{"jboss-logging", "listener-does-not-exist"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmEvents_basicFromInterface(realmName, realmEventsConfig),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`validation error: event listener "listener-does-not-exist" does not exist on the server, installed providers: .+`),
+			},
+		},
+	})
+}
+
 func getRealmEventsFromState(s *terraform.State, resourceName string) (*keycloak.RealmEventsConfig, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -0,0 +1,493 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+const (
+	realmKeyRotationKeyStateActive   = "active"
+	realmKeyRotationKeyStatePassive  = "passive"
+	realmKeyRotationKeyStateDisabled = "disabled"
+)
+
+var (
+	keycloakRealmKeyRotationAlgorithm = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA", "RSA-OAEP", "RSA-OAEP-256"}
+	keycloakRealmKeyRotationKeySize   = []int{1024, 2048, 4096}
+)
+
+// realmKeyRotationKey is a key that is owned by a keycloak_realm_key_rotation resource. The keys are kept in state
+// ordered from the newest generation to the oldest one.
+type realmKeyRotationKey struct {
+	Id         string
+	Name       string
+	Generation int
+	CreatedAt  time.Time
+	State      string
+	Priority   int
+	Kid        string
+}
+
+func resourceKeycloakRealmKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmKeyRotationCreate,
+		ReadContext:   resourceKeycloakRealmKeyRotationRead,
+		UpdateContext: resourceKeycloakRealmKeyRotationUpdate,
+		DeleteContext: resourceKeycloakRealmKeyRotationDelete,
+		CustomizeDiff: resourceKeycloakRealmKeyRotationCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Prefix of the display name of the generated keys. Each key is named after its generation, such as <name>-1.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeyRotationAlgorithm, false),
+				Description:  "Intended algorithm for the keys. It determines the key provider that is used to generate them.",
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(keycloakRealmKeyRotationKeySize),
+				Default:      2048,
+				Description:  "Size of the generated RSA keys. Changes only apply to keys that are generated by the next rotation.",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "Priority of the active key. Older keys get a lower priority for each generation.",
+			},
+			"rotation_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				ValidateFunc:     validateDurationString,
+				Description:      "Duration after which a new key is generated, such as 720h.",
+			},
+			"rotation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value. A new key is generated whenever it changes.",
+			},
+			"grace_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "24h",
+				DiffSuppressFunc: suppressDurationStringDiff,
+				ValidateFunc:     validateDurationString,
+				Description:      "Duration during which a superseded key stays enabled but passive, so that it can still be used to verify tokens.",
+			},
+			"keep_generations": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of key generations that are kept, including the active one. Older keys are deleted.",
+			},
+			"last_rotated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"generation": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"kid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getRealmKeyRotationProvider returns the ID of the generated key provider for the algorithm, along with the
+// provider specific configuration of the keys.
+func getRealmKeyRotationProvider(algorithm string, keySize int) (string, map[string]string) {
+	switch {
+	case algorithm == "EdDSA":
+		return "eddsa-generated", map[string]string{
+			"eddsaEllipticCurveKey": "Ed25519",
+		}
+	case strings.HasPrefix(algorithm, "ES"):
+		curves := map[string]string{
+			"ES256": "P-256",
+			"ES384": "P-384",
+			"ES512": "P-521",
+		}
+
+		return "ecdsa-generated", map[string]string{
+			"ecdsaEllipticCurveKey": curves[algorithm],
+		}
+	case strings.HasPrefix(algorithm, "RSA-"):
+		return "rsa-enc-generated", map[string]string{
+			"algorithm": algorithm,
+			"keySize":   fmt.Sprintf("%d", keySize),
+		}
+	default:
+		return "rsa-generated", map[string]string{
+			"algorithm": algorithm,
+			"keySize":   fmt.Sprintf("%d", keySize),
+		}
+	}
+}
+
+func getRealmKeyRotationKeysFromState(state interface{}) []*realmKeyRotationKey {
+	var keys []*realmKeyRotationKey
+
+	for _, v := range state.([]interface{}) {
+		key := v.(map[string]interface{})
+
+		createdAt, _ := time.Parse(time.RFC3339, key["created_at"].(string))
+
+		keys = append(keys, &realmKeyRotationKey{
+			Id:         key["id"].(string),
+			Name:       key["name"].(string),
+			Generation: key["generation"].(int),
+			CreatedAt:  createdAt,
+			State:      key["state"].(string),
+			Priority:   key["priority"].(int),
+			Kid:        key["kid"].(string),
+		})
+	}
+
+	return keys
+}
+
+func getRealmKeyRotationKeysData(keys []*realmKeyRotationKey) []interface{} {
+	var data []interface{}
+
+	for _, key := range keys {
+		data = append(data, map[string]interface{}{
+			"id":         key.Id,
+			"name":       key.Name,
+			"generation": key.Generation,
+			"created_at": key.CreatedAt.UTC().Format(time.RFC3339),
+			"state":      key.State,
+			"priority":   key.Priority,
+			"kid":        key.Kid,
+		})
+	}
+
+	return data
+}
+
+// getRealmKeyRotationDesiredKeys returns the state and priority that each key should have at the given time, along
+// with the keys that are older than the generations to keep and should be deleted. A key is superseded when the next
+// generation is created, and stays passive until the grace period has passed since then.
+func getRealmKeyRotationDesiredKeys(keys []*realmKeyRotationKey, now time.Time, priority int, gracePeriod time.Duration, keepGenerations int) ([]*realmKeyRotationKey, []*realmKeyRotationKey) {
+	var kept, expired []*realmKeyRotationKey
+
+	for i, key := range keys {
+		if i >= keepGenerations {
+			expired = append(expired, key)
+			continue
+		}
+
+		desired := *key
+		desired.Priority = priority - i
+
+		if i == 0 {
+			desired.State = realmKeyRotationKeyStateActive
+		} else if now.Before(keys[i-1].CreatedAt.Add(gracePeriod)) {
+			desired.State = realmKeyRotationKeyStatePassive
+		} else {
+			desired.State = realmKeyRotationKeyStateDisabled
+		}
+
+		kept = append(kept, &desired)
+	}
+
+	return kept, expired
+}
+
+func realmKeyRotationIsDue(lastRotatedAt string, rotationInterval string, now time.Time) bool {
+	if rotationInterval == "" || lastRotatedAt == "" {
+		return false
+	}
+
+	interval, err := time.ParseDuration(rotationInterval)
+	if err != nil || interval <= 0 {
+		return false
+	}
+
+	lastRotated, err := time.Parse(time.RFC3339, lastRotatedAt)
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(lastRotated.Add(interval))
+}
+
+func getRealmKeyRotationGracePeriod(data interface{ Get(string) interface{} }) time.Duration {
+	gracePeriod, _ := time.ParseDuration(data.Get("grace_period").(string))
+
+	return gracePeriod
+}
+
+// The rotation depends on the current time, so the keys are planned to change whenever a rotation is due or a key
+// has reached the end of its grace period, even when the configuration itself did not change.
+func resourceKeycloakRealmKeyRotationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	now := time.Now()
+
+	if diff.HasChange("rotation_id") || realmKeyRotationIsDue(diff.Get("last_rotated_at").(string), diff.Get("rotation_interval").(string), now) {
+		if err := diff.SetNewComputed("last_rotated_at"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("key")
+	}
+
+	keys := getRealmKeyRotationKeysFromState(diff.Get("key"))
+	desiredKeys, expiredKeys := getRealmKeyRotationDesiredKeys(keys, now, diff.Get("priority").(int), getRealmKeyRotationGracePeriod(diff), diff.Get("keep_generations").(int))
+
+	if len(expiredKeys) != 0 {
+		return diff.SetNewComputed("key")
+	}
+
+	for i, key := range keys {
+		if key.State != desiredKeys[i].State || key.Priority != desiredKeys[i].Priority {
+			return diff.SetNewComputed("key")
+		}
+	}
+
+	return nil
+}
+
+func newRealmKeyRotationKey(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, generation int, now time.Time) (*realmKeyRotationKey, error) {
+	providerId, config := getRealmKeyRotationProvider(data.Get("algorithm").(string), data.Get("key_size").(int))
+
+	realmKey := &keycloak.RealmGeneratedKey{
+		Name:       fmt.Sprintf("%s-%d", data.Get("name").(string), generation),
+		RealmId:    data.Get("realm_id").(string),
+		ProviderId: providerId,
+
+		Active:   true,
+		Enabled:  true,
+		Priority: data.Get("priority").(int),
+		Config:   config,
+	}
+
+	err := keycloakClient.NewRealmGeneratedKey(ctx, realmKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realmKeyRotationKey{
+		Id:         realmKey.Id,
+		Name:       realmKey.Name,
+		Generation: generation,
+		CreatedAt:  now,
+		State:      realmKeyRotationKeyStateActive,
+		Priority:   realmKey.Priority,
+	}, nil
+}
+
+// reconcileRealmKeyRotationKeys brings the flags and priority of each key in line with its desired state, and deletes
+// the keys that are older than the generations to keep. Keys that were deleted outside of Terraform are dropped.
+func reconcileRealmKeyRotationKeys(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, keys []*realmKeyRotationKey, now time.Time) ([]*realmKeyRotationKey, error) {
+	realmId := data.Get("realm_id").(string)
+
+	desiredKeys, expiredKeys := getRealmKeyRotationDesiredKeys(keys, now, data.Get("priority").(int), getRealmKeyRotationGracePeriod(data), data.Get("keep_generations").(int))
+
+	var reconciledKeys []*realmKeyRotationKey
+	for _, key := range desiredKeys {
+		realmKey, err := keycloakClient.GetRealmGeneratedKey(ctx, realmId, key.Id)
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		active := key.State == realmKeyRotationKeyStateActive
+		enabled := key.State != realmKeyRotationKeyStateDisabled
+
+		if realmKey.Active != active || realmKey.Enabled != enabled || realmKey.Priority != key.Priority {
+			realmKey.Active = active
+			realmKey.Enabled = enabled
+			realmKey.Priority = key.Priority
+
+			err = keycloakClient.UpdateRealmGeneratedKey(ctx, realmKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		reconciledKeys = append(reconciledKeys, key)
+	}
+
+	for _, key := range expiredKeys {
+		err := keycloakClient.DeleteRealmGeneratedKey(ctx, realmId, key.Id)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return nil, err
+		}
+	}
+
+	return reconciledKeys, nil
+}
+
+func resourceKeycloakRealmKeyRotationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	now := time.Now()
+
+	key, err := newRealmKeyRotationKey(ctx, keycloakClient, data, 1, now)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", data.Get("realm_id").(string), data.Get("name").(string)))
+	data.Set("key", getRealmKeyRotationKeysData([]*realmKeyRotationKey{key}))
+	data.Set("last_rotated_at", now.UTC().Format(time.RFC3339))
+
+	return resourceKeycloakRealmKeyRotationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeyRotationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	kids, err := keycloakClient.GetRealmKeyIds(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	var keys []*realmKeyRotationKey
+	for _, key := range getRealmKeyRotationKeysFromState(data.Get("key")) {
+		realmKey, err := keycloakClient.GetRealmGeneratedKey(ctx, realmId, key.Id)
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				continue
+			}
+
+			return diag.FromErr(err)
+		}
+
+		if realmKey.Active && realmKey.Enabled {
+			key.State = realmKeyRotationKeyStateActive
+		} else if realmKey.Enabled {
+			key.State = realmKeyRotationKeyStatePassive
+		} else {
+			key.State = realmKeyRotationKeyStateDisabled
+		}
+
+		key.Name = realmKey.Name
+		key.Priority = realmKey.Priority
+		key.Kid = kids[realmKey.Id]
+
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return handleNotFoundError(ctx, &keycloak.ApiError{Code: http.StatusNotFound, Message: fmt.Sprintf("all keys of the key rotation %s have been deleted", data.Id())}, data)
+	}
+
+	data.Set("key", getRealmKeyRotationKeysData(keys))
+
+	return nil
+}
+
+func resourceKeycloakRealmKeyRotationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	now := time.Now()
+
+	// The keys and the time of the last rotation can be planned as unknown, so they are taken from the prior state.
+	oldKeys, _ := data.GetChange("key")
+	oldLastRotatedAt, _ := data.GetChange("last_rotated_at")
+
+	keys := getRealmKeyRotationKeysFromState(oldKeys)
+	lastRotatedAt := oldLastRotatedAt.(string)
+
+	if data.HasChange("rotation_id") || realmKeyRotationIsDue(lastRotatedAt, data.Get("rotation_interval").(string), now) {
+		generation := 1
+		if len(keys) != 0 {
+			generation = keys[0].Generation + 1
+		}
+
+		key, err := newRealmKeyRotationKey(ctx, keycloakClient, data, generation, now)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		keys = append([]*realmKeyRotationKey{key}, keys...)
+		lastRotatedAt = now.UTC().Format(time.RFC3339)
+	}
+
+	keys, err := reconcileRealmKeyRotationKeys(ctx, keycloakClient, data, keys, now)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("key", getRealmKeyRotationKeysData(keys))
+	data.Set("last_rotated_at", lastRotatedAt)
+
+	return resourceKeycloakRealmKeyRotationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeyRotationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	for _, key := range getRealmKeyRotationKeysFromState(data.Get("key")) {
+		err := keycloakClient.DeleteRealmGeneratedKey(ctx, realmId, key.Id)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmKeyRotation_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeyRotationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeyRotation_basic(name, "RS256", "one", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealmKeyRotationKeysExist("keycloak_realm_key_rotation.rotation"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.generation", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.state", "active"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.priority", "100"),
+					resource.TestCheckResourceAttrSet("keycloak_realm_key_rotation.rotation", "key.0.kid"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeyRotation_rotate(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeyRotationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeyRotation_basic(name, "ES256", "one", 2),
+				Check:  testAccCheckRealmKeyRotationKeysExist("keycloak_realm_key_rotation.rotation"),
+			},
+			{
+				Config: testKeycloakRealmKeyRotation_basic(name, "ES256", "two", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealmKeyRotationKeysExist("keycloak_realm_key_rotation.rotation"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.generation", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.state", "active"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.priority", "100"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.1.generation", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.1.state", "passive"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.1.priority", "99"),
+				),
+			},
+			{
+				Config: testKeycloakRealmKeyRotation_basic(name, "ES256", "three", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealmKeyRotationKeysExist("keycloak_realm_key_rotation.rotation"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.generation", "3"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.1.generation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeyRotation_gracePeriodElapsed(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeyRotationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeyRotation_withoutGracePeriod(name, "one"),
+				Check:  testAccCheckRealmKeyRotationKeysExist("keycloak_realm_key_rotation.rotation"),
+			},
+			{
+				Config: testKeycloakRealmKeyRotation_withoutGracePeriod(name, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealmKeyRotationKeysExist("keycloak_realm_key_rotation.rotation"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.state", "active"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.1.state", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeyRotation_keyDeletedOutsideTerraform(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc")
+	var keyId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeyRotationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeyRotation_basic(name, "RS256", "one", 2),
+				Check: func(s *terraform.State) error {
+					keyId = s.RootModule().Resources["keycloak_realm_key_rotation.rotation"].Primary.Attributes["key.0.id"]
+
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteRealmGeneratedKey(testCtx, testAccRealmUserFederation.Realm, keyId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmKeyRotation_basic(name, "RS256", "one", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealmKeyRotationKeysExist("keycloak_realm_key_rotation.rotation"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key.0.generation", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRealmKeyRotationKeysExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+
+		for _, key := range getRealmKeyRotationKeyIdsFromState(rs) {
+			_, err := keycloakClient.GetRealmGeneratedKey(testCtx, realm, key)
+			if err != nil {
+				return fmt.Errorf("error getting key with id %s: %s", key, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRealmKeyRotationDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_key_rotation" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm_id"]
+
+			for _, key := range getRealmKeyRotationKeyIdsFromState(rs) {
+				_, err := keycloakClient.GetRealmGeneratedKey(testCtx, realm, key)
+				if err == nil {
+					return fmt.Errorf("key with id %s still exists", key)
+				}
+
+				if !keycloak.ErrorIs404(err) {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRealmKeyRotationKeyIdsFromState(rs *terraform.ResourceState) []string {
+	var ids []string
+
+	for i := 0; ; i++ {
+		id, ok := rs.Primary.Attributes[fmt.Sprintf("key.%d.id", i)]
+		if !ok {
+			return ids
+		}
+
+		ids = append(ids, id)
+	}
+}
+
+func testKeycloakRealmKeyRotation_basic(name, algorithm, rotationId string, keepGenerations int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_key_rotation" "rotation" {
+	realm_id         = data.keycloak_realm.realm.id
+	name             = "%s"
+	algorithm        = "%s"
+	rotation_id      = "%s"
+	keep_generations = %d
+}
+	`, testAccRealmUserFederation.Realm, name, algorithm, rotationId, keepGenerations)
+}
+
+func testKeycloakRealmKeyRotation_withoutGracePeriod(name, rotationId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_key_rotation" "rotation" {
+	realm_id     = data.keycloak_realm.realm.id
+	name         = "%s"
+	algorithm    = "RS256"
+	rotation_id  = "%s"
+	grace_period = "0s"
+}
+	`, testAccRealmUserFederation.Realm, name, rotationId)
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmLocalization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmLocalizationCreate,
+		ReadContext:   resourceKeycloakRealmLocalizationRead,
+		DeleteContext: resourceKeycloakRealmLocalizationDelete,
+		UpdateContext: resourceKeycloakRealmLocalizationUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmLocalizationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"texts": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The texts that override the message bundle keys of the locale.",
+			},
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, texts of the locale that are not specified in texts are removed. When false, only the specified texts are managed.",
+			},
+		},
+	}
+}
+
+func getRealmLocalizationTextsFromData(data *schema.ResourceData) map[string]string {
+	texts := make(map[string]string)
+	for key, text := range data.Get("texts").(map[string]interface{}) {
+		texts[key] = text.(string)
+	}
+
+	return texts
+}
+
+func resourceKeycloakRealmLocalizationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	data.SetId(fmt.Sprintf("%s/%s", data.Get("realm_id").(string), data.Get("locale").(string)))
+
+	return resourceKeycloakRealmLocalizationUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRealmLocalizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+
+	texts, err := keycloakClient.GetRealmLocalizationTexts(ctx, realmId, locale, false)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// in additive mode, only the texts that are managed by this resource are tracked
+	if !data.Get("authoritative").(bool) {
+		managedTexts := make(map[string]string)
+		for key := range data.Get("texts").(map[string]interface{}) {
+			if text, ok := texts[key]; ok {
+				managedTexts[key] = text
+			}
+		}
+		texts = managedTexts
+	}
+
+	data.Set("texts", texts)
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+	texts := getRealmLocalizationTextsFromData(data)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	var removedKeys []string
+	if data.Get("authoritative").(bool) {
+		existingTexts, err := keycloakClient.GetRealmLocalizationTexts(ctx, realmId, locale, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for key := range existingTexts {
+			if _, ok := texts[key]; !ok {
+				removedKeys = append(removedKeys, key)
+			}
+		}
+	} else {
+		oldTexts, _ := data.GetChange("texts")
+		for key := range oldTexts.(map[string]interface{}) {
+			if _, ok := texts[key]; !ok {
+				removedKeys = append(removedKeys, key)
+			}
+		}
+	}
+
+	for _, key := range removedKeys {
+		err := keycloakClient.DeleteRealmLocalizationText(ctx, realmId, locale, key)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	if len(texts) != 0 {
+		err := keycloakClient.UpdateRealmLocalizationTexts(ctx, realmId, locale, texts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKeycloakRealmLocalizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmLocalizationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	if data.Get("authoritative").(bool) {
+		err := keycloakClient.DeleteRealmLocalizationTexts(ctx, realmId, locale)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+
+	for key := range data.Get("texts").(map[string]interface{}) {
+		err := keycloakClient.DeleteRealmLocalizationText(ctx, realmId, locale, key)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{locale}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("locale", parts[1])
+	d.Set("authoritative", true)
+	d.SetId(fmt.Sprintf("%s/%s", realmId, parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmLocalization_authoritative(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLocalization_realmOnly(realmName),
+				Check: func(_ *terraform.State) error {
+					// texts that are not part of the configuration are removed in authoritative mode
+					return keycloakClient.UpdateRealmLocalizationTexts(testCtx, realmName, "en", map[string]string{"unmanaged": "text"})
+				},
+			},
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, true, "Welcome"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmLocalizationTexts(realmName, "en", map[string]string{"loginTitle": "Welcome", "usernameOrEmail": "Login"}),
+					resource.TestCheckResourceAttr("keycloak_realm_localization.localization", "texts.%", "2"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_localization.localization",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/en",
+			},
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, true, "Hello"),
+				Check:  testAccCheckKeycloakRealmLocalizationTexts(realmName, "en", map[string]string{"loginTitle": "Hello", "usernameOrEmail": "Login"}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmLocalization_additive(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, false, "Welcome"),
+				Check: func(_ *terraform.State) error {
+					// texts that are managed elsewhere are kept in additive mode
+					return keycloakClient.UpdateRealmLocalizationTexts(testCtx, realmName, "en", map[string]string{"unmanaged": "text"})
+				},
+			},
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, false, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmLocalizationTexts(realmName, "en", map[string]string{"loginTitle": "Hello", "usernameOrEmail": "Login", "unmanaged": "text"}),
+					resource.TestCheckResourceAttr("keycloak_realm_localization.localization", "texts.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmLocalizationTexts(realmName, locale string, expected map[string]string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		texts, err := keycloakClient.GetRealmLocalizationTexts(testCtx, realmName, locale, false)
+		if err != nil {
+			return err
+		}
+
+		if len(texts) != len(expected) {
+			return fmt.Errorf("expected realm %s to have %d texts for locale %s, but found %d: %v", realmName, len(expected), locale, len(texts), texts)
+		}
+
+		for key, text := range expected {
+			if texts[key] != text {
+				return fmt.Errorf("expected text %s of locale %s to be %s, but was %s", key, locale, text, texts[key])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmLocalization_basic(realm string, authoritative bool, loginTitle string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	internationalization {
+		supported_locales = ["en", "de"]
+		default_locale    = "en"
+	}
+}
+
+resource "keycloak_realm_localization" "localization" {
+	realm_id      = keycloak_realm.realm.id
+	locale        = "en"
+	authoritative = %t
+
+	texts = {
+		loginTitle      = "%s"
+		usernameOrEmail = "Login"
+	}
+}
+	`, realm, authoritative, loginTitle)
+}
+
+func testKeycloakRealmLocalization_realmOnly(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+	`, realm)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmOtpPolicy() *schema.Resource {
+	otpPolicySchema := realmOtpPolicySchema()
+	otpPolicySchema["realm_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmOtpPolicyCreate,
+		ReadContext:   resourceKeycloakRealmOtpPolicyRead,
+		DeleteContext: resourceKeycloakRealmOtpPolicyDelete,
+		UpdateContext: resourceKeycloakRealmOtpPolicyUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		Schema: otpPolicySchema,
+	}
+}
+
+func getRealmOtpPolicyFromData(data *schema.ResourceData, realm *keycloak.Realm) {
+	otpPolicy := make(map[string]interface{})
+	for key := range realmOtpPolicySchema() {
+		otpPolicy[key] = data.Get(key)
+	}
+
+	setRealmOtpPolicyFromSettings(realm, otpPolicy)
+}
+
+func setRealmOtpPolicyData(data *schema.ResourceData, realm *keycloak.Realm) {
+	for key, value := range getRealmOtpPolicySettings(realm) {
+		data.Set(key, value)
+	}
+}
+
+func resourceKeycloakRealmOtpPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmOtpPolicyUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRealmOtpPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmOtpPolicyData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakRealmOtpPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	realm := &keycloak.Realm{
+		Realm: realmId,
+	}
+	getRealmOtpPolicyFromData(data, realm)
+
+	err := keycloakClient.UpdateRealmFields(ctx, realm, keycloak.RealmOtpPolicyFields)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmOtpPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmOtpPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	// The otp policy cannot be deleted, so instead it is set back to its defaults
+	realm := &keycloak.Realm{
+		Realm: realmId,
+	}
+	setRealmOtpPolicyFromSettings(realm, getDefaultSettings(realmOtpPolicySchema()))
+
+	return diag.FromErr(keycloakClient.UpdateRealmFields(ctx, realm, keycloak.RealmOtpPolicyFields))
+}
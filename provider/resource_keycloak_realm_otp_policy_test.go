@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmOtpPolicy_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmOtpPolicy_basic(realmName, "HmacSHA256", 8),
+				Check:  testAccCheckKeycloakRealmOtpPolicy(realmName, "HmacSHA256", 8),
+			},
+			{
+				ResourceName:      "keycloak_realm_otp_policy.otp_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
+			{
+				Config: testKeycloakRealmOtpPolicy_basic(realmName, "HmacSHA512", 6),
+				Check:  testAccCheckKeycloakRealmOtpPolicy(realmName, "HmacSHA512", 6),
+			},
+			{
+				Config: testKeycloakRealmOtpPolicy_realmOnly(realmName),
+				Check:  testAccCheckKeycloakRealmOtpPolicy(realmName, "HmacSHA1", 6),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmOtpPolicy(realmName, algorithm string, digits int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		realm, err := keycloakClient.GetRealm(testCtx, realmName)
+		if err != nil {
+			return err
+		}
+
+		if realm.OTPPolicyAlgorithm != algorithm {
+			return fmt.Errorf("expected realm %s to have otp algorithm set to %s, but was %s", realmName, algorithm, realm.OTPPolicyAlgorithm)
+		}
+
+		if realm.OTPPolicyDigits != digits {
+			return fmt.Errorf("expected realm %s to have otp digits set to %d, but was %d", realmName, digits, realm.OTPPolicyDigits)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmOtpPolicy_basic(realm, algorithm string, digits int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_otp_policy" "otp_policy" {
+	realm_id = keycloak_realm.realm.id
+
+	algorithm = "%s"
+	digits    = %d
+}
+	`, realm, algorithm, digits)
+}
+
+func testKeycloakRealmOtpPolicy_realmOnly(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+	`, realm)
+}
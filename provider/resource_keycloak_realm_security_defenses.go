@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmSecurityDefenses() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmSecurityDefensesCreate,
+		ReadContext:   resourceKeycloakRealmSecurityDefensesRead,
+		DeleteContext: resourceKeycloakRealmSecurityDefensesDelete,
+		UpdateContext: resourceKeycloakRealmSecurityDefensesUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: realmSecurityHeadersSchema(),
+				},
+			},
+			"brute_force_detection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: realmBruteForceDetectionSchema(),
+				},
+			},
+		},
+	}
+}
+
+func getRealmSecurityDefensesFromData(data *schema.ResourceData, realm *keycloak.Realm) {
+	if v, ok := data.GetOk("headers"); ok {
+		realm.BrowserSecurityHeaders = getBrowserSecurityHeadersFromSettings(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		setDefaultSecuritySettingHeaders(realm)
+	}
+
+	if v, ok := data.GetOk("brute_force_detection"); ok {
+		setRealmBruteForceDetectionFromSettings(realm, v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		setDefaultSecuritySettingsBruteForceDetection(realm)
+	}
+}
+
+func setRealmSecurityDefensesData(data *schema.ResourceData, realm *keycloak.Realm) {
+	// headers always have a value in Keycloak, so they are only read when they are managed by this resource
+	if _, ok := data.GetOk("headers"); ok {
+		data.Set("headers", []interface{}{getHeaderSettings(realm)})
+	}
+
+	if realm.BruteForceProtected {
+		data.Set("brute_force_detection", []interface{}{getBruteForceDetectionSettings(realm)})
+	} else {
+		data.Set("brute_force_detection", nil)
+	}
+}
+
+func resourceKeycloakRealmSecurityDefensesCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmSecurityDefensesUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSecurityDefensesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmSecurityDefensesData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakRealmSecurityDefensesUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	realm := &keycloak.Realm{
+		Realm: realmId,
+	}
+	getRealmSecurityDefensesFromData(data, realm)
+
+	err := keycloakClient.UpdateRealmFields(ctx, realm, keycloak.RealmSecurityDefensesFields)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmSecurityDefensesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSecurityDefensesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	// The security defenses cannot be deleted, so instead they are set back to their defaults
+	realm := &keycloak.Realm{
+		Realm: realmId,
+	}
+	setDefaultSecuritySettingHeaders(realm)
+	setDefaultSecuritySettingsBruteForceDetection(realm)
+
+	return diag.FromErr(keycloakClient.UpdateRealmFields(ctx, realm, keycloak.RealmSecurityDefensesFields))
+}
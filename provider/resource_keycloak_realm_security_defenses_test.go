@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakRealmSecurityDefenses_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSecurityDefenses_basic(realmName, "DENY", 31),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSecurityDefensesHeaders("keycloak_realm.realm", "DENY"),
+					testAccCheckKeycloakRealmSecurityDefensesBruteForceDetection("keycloak_realm.realm", true),
+					testAccCheckKeycloakRealmSecurityDefensesBruteForceDetectionFailureFactor("keycloak_realm.realm", 31),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_security_defenses.security_defenses",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+				// headers are only read when they are already managed
+				ImportStateVerifyIgnore: []string{"headers"},
+			},
+			{
+				Config: testKeycloakRealmSecurityDefenses_basic(realmName, "SAMEORIGIN", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSecurityDefensesHeaders("keycloak_realm.realm", "SAMEORIGIN"),
+					testAccCheckKeycloakRealmSecurityDefensesBruteForceDetection("keycloak_realm.realm", true),
+					testAccCheckKeycloakRealmSecurityDefensesBruteForceDetectionFailureFactor("keycloak_realm.realm", 10),
+				),
+			},
+			{
+				Config: testKeycloakRealmSecurityDefenses_realmOnly(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSecurityDefensesHeaders("keycloak_realm.realm", "SAMEORIGIN"),
+					testAccCheckKeycloakRealmSecurityDefensesBruteForceDetection("keycloak_realm.realm", false),
+					testAccCheckKeycloakRealmSecurityDefensesBruteForceDetectionFailureFactor("keycloak_realm.realm", 30),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakRealmSecurityDefenses_basic(realm, xFrameOptions string, maxLoginFailures int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_security_defenses" "security_defenses" {
+	realm_id = keycloak_realm.realm.id
+
+	headers {
+		x_frame_options = "%s"
+	}
+
+	brute_force_detection {
+		max_login_failures = %d
+	}
+}
+	`, realm, xFrameOptions, maxLoginFailures)
+}
+
+func testKeycloakRealmSecurityDefenses_realmOnly(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+	`, realm)
+}
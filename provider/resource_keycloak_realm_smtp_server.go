@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmSmtpServer() *schema.Resource {
+	smtpServerSchema := realmSmtpServerSchema()
+	smtpServerSchema["realm_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmSmtpServerCreate,
+		ReadContext:   resourceKeycloakRealmSmtpServerRead,
+		DeleteContext: resourceKeycloakRealmSmtpServerDelete,
+		UpdateContext: resourceKeycloakRealmSmtpServerUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		CustomizeDiff: customizeDiffValidateRealmSmtpServerVerification,
+		Schema:        smtpServerSchema,
+	}
+}
+
+// Without verify_recipient, Keycloak sends the test email of verify_on_apply to the user the provider is authenticated
+// as, and fails with an opaque error when that user has no email address, so this is checked when planning.
+func validateSmtpTestRecipient(ctx context.Context, meta interface{}) error {
+	keycloakClient, ok := meta.(*keycloak.KeycloakClient)
+	if !ok || keycloakClient == nil {
+		return nil
+	}
+
+	email, err := keycloakClient.GetAuthenticatedUserEmail(ctx)
+	if err != nil {
+		return fmt.Errorf("error checking the recipient of the SMTP test email for verify_on_apply: %s", err)
+	}
+
+	if email == "" {
+		return fmt.Errorf("verify_on_apply requires verify_recipient to be set, or the user the provider is authenticated as to have an email address, since Keycloak sends the SMTP test email to it")
+	}
+
+	return nil
+}
+
+func customizeDiffValidateRealmSmtpServerVerification(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("verify_on_apply").(bool) || d.Get("verify_recipient").(string) != "" {
+		return nil
+	}
+
+	var keys []string
+	for key := range realmSmtpServerSchema() {
+		keys = append(keys, key)
+	}
+
+	if d.Id() != "" && !d.HasChanges(keys...) {
+		return nil
+	}
+
+	return validateSmtpTestRecipient(ctx, meta)
+}
+
+func smtpServerVerificationError(err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  "The SMTP settings could not be verified",
+			Detail:   err.Error(),
+		},
+	}
+}
+
+func getRealmSmtpServerFromData(data *schema.ResourceData) keycloak.SmtpServer {
+	smtpSettings := make(map[string]interface{})
+	for key := range realmSmtpServerSchema() {
+		smtpSettings[key] = data.Get(key)
+	}
+
+	return getRealmSmtpServerFromSettings(smtpSettings)
+}
+
+func setRealmSmtpServerData(data *schema.ResourceData, smtpServer keycloak.SmtpServer) {
+	smtpSettings := getRealmSmtpServerSettings(smtpServer)
+	smtpSettings["verify_on_apply"] = data.Get("verify_on_apply")
+	smtpSettings["verify_recipient"] = data.Get("verify_recipient")
+
+	for key := range realmSmtpServerSchema() {
+		data.Set(key, smtpSettings[key])
+	}
+}
+
+func resourceKeycloakRealmSmtpServerCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmSmtpServerUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSmtpServerRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// the API responds with "**********" instead of the password, so the password from state is kept
+	if password, ok := data.GetOk("auth.0.password"); ok && bool(realm.SmtpServer.Auth) {
+		realm.SmtpServer.Password = password.(string)
+	}
+
+	setRealmSmtpServerData(data, realm.SmtpServer)
+
+	return nil
+}
+
+func resourceKeycloakRealmSmtpServerUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	realm := &keycloak.Realm{
+		Realm:      realmId,
+		SmtpServer: getRealmSmtpServerFromData(data),
+	}
+
+	if data.Get("verify_on_apply").(bool) {
+		err := keycloakClient.TestRealmSmtpConnection(ctx, realmId, realm.SmtpServer, data.Get("verify_recipient").(string))
+		if err != nil {
+			return smtpServerVerificationError(err)
+		}
+	}
+
+	err := keycloakClient.UpdateRealmFields(ctx, realm, keycloak.RealmSmtpServerFields)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmSmtpServerRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSmtpServerDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	// The smtp server cannot be deleted, so instead it is cleared
+	realm := &keycloak.Realm{
+		Realm: realmId,
+	}
+
+	return diag.FromErr(keycloakClient.UpdateRealmFields(ctx, realm, keycloak.RealmSmtpServerFields))
+}
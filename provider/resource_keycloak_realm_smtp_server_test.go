@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmSmtpServer_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "My Host"),
+				Check:  testAccCheckKeycloakRealmSmtp("keycloak_realm.realm", "myhost.com", "tom@myhost.com", "tom"),
+			},
+			{
+				ResourceName:            "keycloak_realm_smtp_server.smtp_server",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           realmName,
+				ImportStateVerifyIgnore: []string{"auth.0.password"},
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost2.com", "My Host"),
+				Check:  testAccCheckKeycloakRealmSmtp("keycloak_realm.realm", "myhost2.com", "tom@myhost.com", "tom"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_realmUpdateKeepsSmtpServer(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "My Host"),
+				Check:  testAccCheckKeycloakRealmSmtp("keycloak_realm.realm", "myhost.com", "tom@myhost.com", "tom"),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "My Other Host"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "display_name", "My Other Host"),
+					testAccCheckKeycloakRealmSmtp("keycloak_realm.realm", "myhost.com", "tom@myhost.com", "tom"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_destroy(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "My Host"),
+				Check:  testAccCheckKeycloakRealmSmtp("keycloak_realm.realm", "myhost.com", "tom@myhost.com", "tom"),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_realmOnly(realmName),
+				Check: func(state *terraform.State) error {
+					realm, err := keycloakClient.GetRealm(testCtx, realmName)
+					if err != nil {
+						return err
+					}
+
+					if realm.SmtpServer.Host != "" {
+						return fmt.Errorf("expected realm %s to have no smtp server after destroy, but host was %s", realmName, realm.SmtpServer.Host)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_verifyOnApplyFails(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_realmOnly(realmName),
+			},
+			{
+				Config:      testKeycloakRealmSmtpServer_verifyOnApply(realmName, "localhost", "1"),
+				ExpectError: regexp.MustCompile("The SMTP settings could not be verified"),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_realmOnly(realmName),
+				Check: func(state *terraform.State) error {
+					realm, err := keycloakClient.GetRealm(testCtx, realmName)
+					if err != nil {
+						return err
+					}
+
+					if realm.SmtpServer.Host != "" {
+						return fmt.Errorf("expected the smtp server of realm %s to not be applied when it cannot be verified, but host was %s", realmName, realm.SmtpServer.Host)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// Keycloak sends the test email to the email address of the user the provider is authenticated as. KEYCLOAK_TEST_SMTP_HOST
+// is the host of an SMTP server that accepts any email on port 1025, as seen from Keycloak, such as the mailhog service of
+// docker-compose.yml.
+func TestAccKeycloakRealmSmtpServer_verifyOnApply(t *testing.T) {
+	skipIfEnvNotSet(t, "KEYCLOAK_TEST_SMTP_HOST")
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_verifyOnApply(realmName, os.Getenv("KEYCLOAK_TEST_SMTP_HOST"), "1025"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_smtp_server.smtp_server", "verify_on_apply", "true"),
+					resource.TestCheckResourceAttr("keycloak_realm_smtp_server.smtp_server", "host", os.Getenv("KEYCLOAK_TEST_SMTP_HOST")),
+				),
+			},
+		},
+	})
+}
+
+// The test email is sent to verify_recipient, which is set on the user the provider is authenticated as while it is sent,
+// so the user's own email address must be restored afterwards.
+func TestAccKeycloakRealmSmtpServer_verifyRecipient(t *testing.T) {
+	skipIfEnvNotSet(t, "KEYCLOAK_TEST_SMTP_HOST")
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	email, err := keycloakClient.GetAuthenticatedUserEmail(testCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_verifyRecipient(realmName, os.Getenv("KEYCLOAK_TEST_SMTP_HOST"), "1025", "smtp-test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_smtp_server.smtp_server", "verify_recipient", "smtp-test@example.com"),
+					func(_ *terraform.State) error {
+						restoredEmail, err := keycloakClient.GetAuthenticatedUserEmail(testCtx)
+						if err != nil {
+							return err
+						}
+
+						if restoredEmail != email {
+							return fmt.Errorf("expected the email address of the authenticated user to be restored to %q, but was %q", email, restoredEmail)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakRealmSmtpServer_basic(realm, host, displayName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	display_name = "%s"
+}
+
+resource "keycloak_realm_smtp_server" "smtp_server" {
+	realm_id = keycloak_realm.realm.id
+
+	host              = "%s"
+	port              = 25
+	from              = "tom@myhost.com"
+	from_display_name = "Tom"
+	starttls          = true
+
+	auth {
+		username = "tom"
+		password = "tom"
+	}
+}
+	`, realm, displayName, host)
+}
+
+func testKeycloakRealmSmtpServer_realmOnly(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	display_name = "My Host"
+}
+	`, realm)
+}
+
+func testKeycloakRealmSmtpServer_verifyOnApply(realm, host, port string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	display_name = "My Host"
+}
+
+resource "keycloak_realm_smtp_server" "smtp_server" {
+	realm_id        = keycloak_realm.realm.id
+	verify_on_apply = true
+
+	host = "%s"
+	port = "%s"
+	from = "tom@myhost.com"
+}
+	`, realm, host, port)
+}
+
+func testKeycloakRealmSmtpServer_verifyRecipient(realm, host, port, recipient string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	display_name = "My Host"
+}
+
+resource "keycloak_realm_smtp_server" "smtp_server" {
+	realm_id         = keycloak_realm.realm.id
+	verify_on_apply  = true
+	verify_recipient = "%s"
+
+	host = "%s"
+	port = "%s"
+	from = "tom@myhost.com"
+}
+	`, realm, recipient, host, port)
+}
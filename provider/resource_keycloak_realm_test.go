@@ -6,7 +6,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -91,6 +93,68 @@ func TestAccKeycloakRealm_import(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_deletionProtection(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_deletionProtection(realmName, true),
+				Check:  testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+			},
+			{
+				Config:      testKeycloakRealm_withoutRealm(),
+				ExpectError: regexp.MustCompile("realm is protected from deletion"),
+			},
+			{
+				Config: testKeycloakRealm_deletionProtection(realmName, false),
+				Check:  testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_updateKeepsUnmanagedAttributes(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayNameHtml := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName, realmDisplayNameHtml),
+				Check:  testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+			},
+			{
+				PreConfig: func() {
+					realm, err := keycloakClient.GetRealm(testCtx, realmName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					realm.Attributes["unmanagedAttribute"] = "value"
+
+					err = keycloakClient.UpdateRealm(testCtx, realm)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealm_basic(realmName, fmt.Sprintf("%s-changed", realmDisplayName), realmDisplayNameHtml),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmDisplayName("keycloak_realm.realm", fmt.Sprintf("%s-changed", realmDisplayName)),
+					testAccCheckKeycloakRealmHasAttribute("keycloak_realm.realm", "unmanagedAttribute", "value"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_OTP(t *testing.T) {
 	realm := acctest.RandomWithPrefix("tf-acc")
 
@@ -172,6 +236,55 @@ func TestAccKeycloakRealm_SmtpServerInvalid(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_SmtpServerVerifyOnApplyFails(t *testing.T) {
+	realm := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealm_WithSmtpServerVerifyOnApply(realm, "localhost", "1"),
+				ExpectError: regexp.MustCompile("The SMTP settings could not be verified"),
+			},
+			{
+				Config: `locals {}`,
+				Check: func(_ *terraform.State) error {
+					if _, err := keycloakClient.GetRealm(testCtx, realm); err == nil {
+						return fmt.Errorf("expected realm %s to not be created when its smtp server cannot be verified", realm)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// See TestAccKeycloakRealmSmtpServer_verifyOnApply
+func TestAccKeycloakRealm_SmtpServerVerifyOnApply(t *testing.T) {
+	skipIfEnvNotSet(t, "KEYCLOAK_TEST_SMTP_HOST")
+
+	realm := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_WithSmtpServerVerifyOnApply(realm, os.Getenv("KEYCLOAK_TEST_SMTP_HOST"), "1025"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm.realm", "smtp_server.0.verify_on_apply", "true"),
+			},
+			{
+				Config:      testKeycloakRealm_WithSmtpServerVerifyOnApply(realm, "localhost", "1"),
+				ExpectError: regexp.MustCompile("The SMTP settings could not be verified"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_themes(t *testing.T) {
 	realmOne := &keycloak.Realm{
 		Realm:        "terraform-" + acctest.RandString(10),
@@ -392,6 +505,33 @@ func TestAccKeycloakRealm_tokenSettings(t *testing.T) {
 	})
 }
 
+// Token settings that are removed from the configuration keep their value, since they may be managed by
+// keycloak_realm_token_settings instead.
+func TestAccKeycloakRealm_tokenSettingsRemoved(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayNameHtml := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_refreshTokenSettings(realmName),
+				Check:  testAccCheckKeycloakRealmRefreshTokenSettings("keycloak_realm.realm", true, 2),
+			},
+			{
+				Config: testKeycloakRealm_basic(realmName, realmName, realmDisplayNameHtml),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmRefreshTokenSettings("keycloak_realm.realm", true, 2),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "revoke_refresh_token", "true"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "refresh_token_max_reuse", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_tokenSettingsOauth2Device(t *testing.T) {
 	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_13); !ok {
 		t.Skip()
@@ -506,6 +646,65 @@ func TestAccKeycloakRealm_oauth2DeviceSettings(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_cibaPolicy(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_13); !ok {
+		t.Skip()
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_cibaPolicy(realmName, "poll", "2m", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmHasAttribute("keycloak_realm.realm", keycloak.RealmAttributeCibaBackchannelTokenDeliveryMode, "poll"),
+					testAccCheckKeycloakRealmHasAttribute("keycloak_realm.realm", keycloak.RealmAttributeCibaExpiresIn, "120"),
+					testAccCheckKeycloakRealmHasAttribute("keycloak_realm.realm", keycloak.RealmAttributeCibaInterval, "5"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.backchannel_token_delivery_mode", "poll"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.auth_requested_user_hint", "login_hint"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_cibaPolicy(realmName, "ping", "5m", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmHasAttribute("keycloak_realm.realm", keycloak.RealmAttributeCibaBackchannelTokenDeliveryMode, "ping"),
+					testAccCheckKeycloakRealmHasAttribute("keycloak_realm.realm", keycloak.RealmAttributeCibaExpiresIn, "300"),
+					testAccCheckKeycloakRealmHasAttribute("keycloak_realm.realm", keycloak.RealmAttributeCibaInterval, "10"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm.realm",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_cibaPolicyValidation(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealm_cibaPolicy(realmName, "push", "2m", 5),
+				ExpectError: regexp.MustCompile("expected ciba_policy.0.backchannel_token_delivery_mode to be one of"),
+			},
+			{
+				Config:      testKeycloakRealm_cibaPolicy(realmName, "poll", "2m", 1000),
+				ExpectError: regexp.MustCompile("expected ciba_policy.0.interval to be in the range"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_securityDefensesHeaders(t *testing.T) {
 	realmName := acctest.RandomWithPrefix("tf-acc")
 	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
@@ -754,6 +953,68 @@ func TestAccKeycloakRealm_passwordPolicyInvalid(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_passwordPolicyRules(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, []string{"upperCase", "length", "notUsername"}, []string{"1", "8", ""}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "length(8) and notUsername(undefined) and upperCase(1)"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy_rule.#", "3"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy", ""),
+				),
+			},
+			// the order of the rules does not matter
+			{
+				Config:   testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, []string{"notUsername", "length", "upperCase"}, []string{"", "8", "1"}),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, []string{"length"}, []string{"12"}),
+				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "length(12)"),
+			},
+			{
+				Config: testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, "lowerCase(2)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "lowerCase(2)"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy_rule.#", "0"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, []string{"lowerCase"}, []string{"2"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "lowerCase(2)"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy_rule.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_passwordPolicyRulesInvalid(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, []string{"length", "unknownpolicy"}, []string{"8", "1"}),
+				ExpectError: regexp.MustCompile("validation error: password policy \"unknownpolicy\" does not exist on the server, available password policies: .+"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_createWithInternalId(t *testing.T) {
 	realmName := "my-realm"
 	internalId := acctest.RandomWithPrefix("tf-acc")
@@ -1033,6 +1294,21 @@ func testAccCheckKeycloakRealmExists(resourceName string) resource.TestCheckFunc
 	}
 }
 
+func testAccCheckKeycloakRealmHasAttribute(resourceName, attribute, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if realm.Attributes[attribute] != value {
+			return fmt.Errorf("expected realm %s to have attribute %s with value %s, but was %v", realm.Realm, attribute, value, realm.Attributes[attribute])
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakRealmEnabled(resourceName string, enabled bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		realm, err := getRealmFromState(s, resourceName)
@@ -1187,6 +1463,25 @@ func testAccCheckKeycloakRealmDestroy() resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckKeycloakRealmRefreshTokenSettings(resourceName string, revokeRefreshToken bool, refreshTokenMaxReuse int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if realm.RevokeRefreshToken != revokeRefreshToken {
+			return fmt.Errorf("expected realm %s to have revoke_refresh_token set to %t, but was %t", realm.Realm, revokeRefreshToken, realm.RevokeRefreshToken)
+		}
+
+		if realm.RefreshTokenMaxReuse != refreshTokenMaxReuse {
+			return fmt.Errorf("expected realm %s to have refresh_token_max_reuse set to %d, but was %d", realm.Realm, refreshTokenMaxReuse, realm.RefreshTokenMaxReuse)
+		}
+
+		return nil
+	}
+}
+
 func getRealmFromState(s *terraform.State, resourceName string) (*keycloak.Realm, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -1319,6 +1614,37 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, realmDisplayNameHtml)
 }
 
+func testKeycloakRealm_cibaPolicy(realm, deliveryMode, expiresIn string, interval int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	ciba_policy {
+		backchannel_token_delivery_mode = "%s"
+		expires_in                      = "%s"
+		interval                        = %d
+	}
+}
+	`, realm, deliveryMode, expiresIn, interval)
+}
+
+func testKeycloakRealm_deletionProtection(realm string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm               = "%s"
+	deletion_protection = %t
+}
+	`, realm, deletionProtection)
+}
+
+func testKeycloakRealm_withoutRealm() string {
+	return `
+data "keycloak_realm" "master" {
+	realm = "master"
+}
+	`
+}
+
 func testKeycloakRealm_WithSmtpServer(realm, host, from, user string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1344,6 +1670,21 @@ resource "keycloak_realm" "realm" {
 	`, realm, realm, host, from, user)
 }
 
+func testKeycloakRealm_WithSmtpServerVerifyOnApply(realm, host, port string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	smtp_server {
+		host            = "%s"
+		port            = "%s"
+		from            = "tom@myhost.com"
+		verify_on_apply = true
+	}
+}
+	`, realm, host, port)
+}
+
 func testKeycloakRealm_WithOTP(realm, otpType, algorithm string, period int) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1522,6 +1863,16 @@ resource "keycloak_realm" "realm" {
 	`, realm)
 }
 
+func testKeycloakRealm_refreshTokenSettings(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                   = "%s"
+	revoke_refresh_token    = true
+	refresh_token_max_reuse = 2
+}
+	`, realm)
+}
+
 func testKeycloakRealm_tokenSettings(realm string) string {
 	defaultSignatureAlgorithm := "RS256"
 	ssoSessionIdleTimeout := randomDurationString()
@@ -1667,6 +2018,27 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, passwordPolicy)
 }
 
+func testKeycloakRealm_passwordPolicyRules(realm, realmDisplayName string, types, values []string) string {
+	var rules strings.Builder
+	for i := range types {
+		rules.WriteString(fmt.Sprintf(`
+	password_policy_rule {
+		type  = "%s"
+		value = "%s"
+	}
+`, types[i], values[i]))
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+%s
+}
+	`, realm, realmDisplayName, rules.String())
+}
+
 func testKeycloakRealm_browserFlow(realm, realmDisplayName, browserFlow string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
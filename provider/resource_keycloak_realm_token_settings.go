@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmTokenSettings() *schema.Resource {
+	// settings that are not specified keep their current value instead of being reset to their default
+	tokenSettingsSchema := realmTokenSettingsSchema()
+	for _, attributeSchema := range tokenSettingsSchema {
+		attributeSchema.Default = nil
+		attributeSchema.Computed = true
+	}
+
+	tokenSettingsSchema["realm_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmTokenSettingsCreate,
+		ReadContext:   resourceKeycloakRealmTokenSettingsRead,
+		DeleteContext: resourceKeycloakRealmTokenSettingsDelete,
+		UpdateContext: resourceKeycloakRealmTokenSettingsUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		Schema: tokenSettingsSchema,
+	}
+}
+
+func resourceKeycloakRealmTokenSettingsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmTokenSettingsUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRealmTokenSettingsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmTokenSettingsData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakRealmTokenSettingsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	realm := &keycloak.Realm{
+		Realm: realmId,
+	}
+
+	err := setRealmTokenSettingsFromData(data, realm)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// only the settings that are configured are updated, the others keep their current value
+	err = keycloakClient.UpdateRealmFields(ctx, realm, getConfiguredRealmTokenSettingsFields(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmTokenSettingsRead(ctx, data, meta)
+}
+
+// Keycloak does not keep track of the default token settings of a realm, so they are left unchanged when this resource
+// is deleted.
+func resourceKeycloakRealmTokenSettingsDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
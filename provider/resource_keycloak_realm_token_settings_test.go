@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmTokenSettings_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "My Realm", "5m"),
+				Check:  testAccCheckKeycloakRealmTokenSettings(realmName, true, 300),
+			},
+			{
+				ResourceName:      "keycloak_realm_token_settings.token_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "My Other Realm", "10m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "display_name", "My Other Realm"),
+					testAccCheckKeycloakRealmTokenSettings(realmName, true, 600),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmTokenSettings(realmName string, revokeRefreshToken bool, accessTokenLifespan int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		realm, err := keycloakClient.GetRealm(testCtx, realmName)
+		if err != nil {
+			return err
+		}
+
+		if realm.RevokeRefreshToken != revokeRefreshToken {
+			return fmt.Errorf("expected realm %s to have revoke_refresh_token set to %t, but was %t", realmName, revokeRefreshToken, realm.RevokeRefreshToken)
+		}
+
+		if realm.AccessTokenLifespan != accessTokenLifespan {
+			return fmt.Errorf("expected realm %s to have access_token_lifespan set to %d, but was %d", realmName, accessTokenLifespan, realm.AccessTokenLifespan)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmTokenSettings_basic(realm, displayName, accessTokenLifespan string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	display_name = "%s"
+}
+
+resource "keycloak_realm_token_settings" "token_settings" {
+	realm_id = keycloak_realm.realm.id
+
+	revoke_refresh_token  = true
+	access_token_lifespan = "%s"
+}
+	`, realm, displayName, accessTokenLifespan)
+}
@@ -3,10 +3,12 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 )
 
@@ -16,6 +18,9 @@ func resourceKeycloakRealmUserProfile() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmUserProfileRead,
 		DeleteContext: resourceKeycloakRealmUserProfileDelete,
 		UpdateContext: resourceKeycloakRealmUserProfileUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -26,114 +31,156 @@ func resourceKeycloakRealmUserProfile() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"display_name": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"group": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"enabled_when_scope": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"required_for_roles": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"required_for_scopes": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"permissions": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"view": {
-										Type:     schema.TypeSet,
-										Set:      schema.HashString,
-										Required: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"edit": {
-										Type:     schema.TypeSet,
-										Set:      schema.HashString,
-										Required: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-						"validator": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"config": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-						"annotations": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
+					Schema: realmUserProfileAttributeSchema(),
 				},
 			},
 			"group": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"display_header": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"display_description": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"annotations": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
+					Schema: realmUserProfileGroupSchema(),
+				},
+			},
+			"unmanaged_attribute_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "ADMIN_EDIT", "ADMIN_VIEW"}, false),
+				Description:  "The policy for attributes that are not part of the user profile. Requires Keycloak 24 or later.",
+			},
+		},
+	}
+}
+
+// The schemas of user profile attributes and groups are shared with the keycloak_realm_user_profile_attribute and
+// keycloak_realm_user_profile_group resources, which manage a single entry of the user profile.
+func realmUserProfileAttributeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"group": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"multivalued": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"enabled_when_scope": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"required_for_roles": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"required_for_scopes": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"permissions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"view": {
+						Type:     schema.TypeSet,
+						Set:      schema.HashString,
+						Required: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"edit": {
+						Type:     schema.TypeSet,
+						Set:      schema.HashString,
+						Required: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"validator": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"config": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
 					},
 				},
 			},
 		},
+		"annotations": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
 	}
 }
 
+func realmUserProfileGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_header": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"display_description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"annotations": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// Validator configs and annotations are maps of strings in Terraform. Values that are JSON lists or objects, such as
+// the options of the "options" validator or the "inputOptionLabels" annotation, are sent to Keycloak as JSON.
+func decodeRealmUserProfileValues(values map[string]interface{}) map[string]interface{} {
+	decoded := make(map[string]interface{})
+
+	for key, value := range values {
+		s := value.(string)
+		if strings.HasPrefix(s, "[") || strings.HasPrefix(s, "{") {
+			var t interface{}
+			if err := json.Unmarshal([]byte(s), &t); err == nil {
+				decoded[key] = t
+				continue
+			}
+		}
+
+		decoded[key] = value
+	}
+
+	return decoded
+}
+
 func getRealmUserProfileAttributeFromData(m map[string]interface{}) *keycloak.RealmUserProfileAttribute {
 	attribute := &keycloak.RealmUserProfileAttribute{
 		Name:        m["name"].(string),
 		DisplayName: m["display_name"].(string),
 		Group:       m["group"].(string),
+		Multivalued: m["multivalued"].(bool),
 	}
 
 	if v, ok := m["permissions"]; ok && len(v.([]interface{})) > 0 {
@@ -183,16 +230,7 @@ func getRealmUserProfileAttributeFromData(m map[string]interface{}) *keycloak.Re
 
 			config := make(map[string]interface{})
 			if v, ok := validationConfig["config"]; ok {
-				for key, value := range v.(map[string]interface{}) {
-					if strings.HasPrefix(value.(string), "[") {
-						t := make([]interface{}, 0)
-						json.Unmarshal([]byte(value.(string)), &t)
-
-						config[key] = t
-					} else {
-						config[key] = value
-					}
-				}
+				config = decodeRealmUserProfileValues(v.(map[string]interface{}))
 			}
 
 			validations[name] = config
@@ -215,19 +253,7 @@ func getRealmUserProfileAttributeFromData(m map[string]interface{}) *keycloak.Re
 	}
 
 	if v, ok := m["annotations"]; ok {
-		annotations := make(map[string]interface{})
-
-		for key, value := range v.(map[string]interface{}) {
-
-			if strings.HasPrefix(value.(string), "{") {
-				var t interface{}
-				json.Unmarshal([]byte(value.(string)), &t)
-				annotations[key] = t
-			} else {
-				annotations[key] = value
-			}
-		}
-		attribute.Annotations = annotations
+		attribute.Annotations = decodeRealmUserProfileValues(v.(map[string]interface{}))
 	}
 
 	return attribute
@@ -255,20 +281,7 @@ func getRealmUserProfileGroupFromData(m map[string]interface{}) *keycloak.RealmU
 	}
 
 	if v, ok := m["annotations"]; ok {
-		annotations := make(map[string]interface{})
-
-		for key, value := range v.(map[string]interface{}) {
-			if strings.HasPrefix(value.(string), "{") {
-				var t interface{}
-				json.Unmarshal([]byte(value.(string)), &t)
-
-				annotations[key] = t
-			} else {
-				annotations[key] = value
-			}
-		}
-
-		group.Annotations = annotations
+		group.Annotations = decodeRealmUserProfileValues(v.(map[string]interface{}))
 	}
 
 	return &group
@@ -288,7 +301,9 @@ func getRealmUserProfileGroupsFromData(lst []interface{}) []*keycloak.RealmUserP
 }
 
 func getRealmUserProfileFromData(data *schema.ResourceData) *keycloak.RealmUserProfile {
-	realmUserProfile := &keycloak.RealmUserProfile{}
+	realmUserProfile := &keycloak.RealmUserProfile{
+		UnmanagedAttributePolicy: data.Get("unmanaged_attribute_policy").(string),
+	}
 
 	realmUserProfile.Attributes = getRealmUserProfileAttributesFromData(data.Get("attribute").([]interface{}))
 	realmUserProfile.Groups = getRealmUserProfileGroupsFromData(data.Get("group").(*schema.Set).List())
@@ -303,6 +318,7 @@ func getRealmUserProfileAttributeData(attr *keycloak.RealmUserProfileAttribute)
 
 	attributeData["display_name"] = attr.DisplayName
 	attributeData["group"] = attr.Group
+	attributeData["multivalued"] = attr.Multivalued
 	if attr.Selector != nil && len(attr.Selector.Scopes) != 0 {
 		attributeData["enabled_when_scope"] = attr.Selector.Scopes
 	}
@@ -330,17 +346,7 @@ func getRealmUserProfileAttributeData(attr *keycloak.RealmUserProfileAttribute)
 
 			validator["name"] = name
 
-			c := make(map[string]interface{})
-			for k, v := range config {
-				if _, ok := v.([]interface{}); ok {
-					t, _ := json.Marshal(v)
-					c[k] = string(t)
-				} else {
-					c[k] = v
-				}
-			}
-
-			validator["config"] = c
+			validator["config"] = config
 
 			validations = append(validations, validator)
 		}
@@ -348,18 +354,7 @@ func getRealmUserProfileAttributeData(attr *keycloak.RealmUserProfileAttribute)
 	}
 
 	if attr.Annotations != nil {
-		annotations := make(map[string]interface{})
-
-		for k, v := range attr.Annotations {
-			if _, ok := v.(map[string]interface{}); ok {
-				t, _ := json.Marshal(v)
-				annotations[k] = string(t)
-			} else {
-				annotations[k] = v
-			}
-		}
-
-		attributeData["annotations"] = annotations
+		attributeData["annotations"] = attr.Annotations
 	}
 
 	return attributeData
@@ -372,18 +367,7 @@ func getRealmUserProfileGroupData(group *keycloak.RealmUserProfileGroup) map[str
 	groupData["display_header"] = group.DisplayHeader
 	groupData["display_description"] = group.DisplayDescription
 
-	annotations := make(map[string]interface{})
-
-	for k, v := range group.Annotations {
-		if _, ok := v.(map[string]interface{}); ok {
-			t, _ := json.Marshal(v)
-			annotations[k] = string(t)
-		} else {
-			annotations[k] = v
-		}
-	}
-
-	groupData["annotations"] = annotations
+	groupData["annotations"] = group.Annotations
 
 	return groupData
 }
@@ -400,6 +384,25 @@ func setRealmUserProfileData(data *schema.ResourceData, realmUserProfile *keyclo
 		groups = append(groups, getRealmUserProfileGroupData(group))
 	}
 	data.Set("group", groups)
+
+	data.Set("unmanaged_attribute_policy", realmUserProfile.UnmanagedAttributePolicy)
+}
+
+func checkRealmUserProfileUnmanagedAttributePolicySupported(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmUserProfile *keycloak.RealmUserProfile) error {
+	if realmUserProfile.UnmanagedAttributePolicy == "" {
+		return nil
+	}
+
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_24)
+	if err != nil {
+		return err
+	}
+
+	if !versionOk {
+		return fmt.Errorf("unmanaged_attribute_policy requires Keycloak 24 or later")
+	}
+
+	return nil
 }
 
 func resourceKeycloakRealmUserProfileCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -409,7 +412,16 @@ func resourceKeycloakRealmUserProfileCreate(ctx context.Context, data *schema.Re
 
 	realmUserProfile := getRealmUserProfileFromData(data)
 
-	err := keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
+	err := checkRealmUserProfileUnmanagedAttributePolicySupported(ctx, keycloakClient, realmUserProfile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	err = keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -442,6 +454,10 @@ func resourceKeycloakRealmUserProfileDelete(ctx context.Context, data *schema.Re
 		Groups:     []*keycloak.RealmUserProfileGroup{},
 	}
 
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	err := keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
 	if err != nil {
 		return diag.FromErr(err)
@@ -456,12 +472,19 @@ func resourceKeycloakRealmUserProfileUpdate(ctx context.Context, data *schema.Re
 	realmId := data.Get("realm_id").(string)
 	realmUserProfile := getRealmUserProfileFromData(data)
 
-	err := keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
+	err := checkRealmUserProfileUnmanagedAttributePolicySupported(ctx, keycloakClient, realmUserProfile)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	setRealmUserProfileData(data, realmUserProfile)
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
 
-	return nil
+	err = keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmUserProfileRead(ctx, data, meta)
 }
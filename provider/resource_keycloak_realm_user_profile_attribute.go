@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// Keycloak requires these attributes to be part of the user profile, so they are left in place when the resource is
+// destroyed.
+var realmUserProfileBuiltInAttributes = []string{"username", "email"}
+
+func resourceKeycloakRealmUserProfileAttribute() *schema.Resource {
+	attributeSchema := realmUserProfileAttributeSchema()
+	attributeSchema["name"].ForceNew = true
+	attributeSchema["realm_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmUserProfileAttributeCreate,
+		ReadContext:   resourceKeycloakRealmUserProfileAttributeRead,
+		DeleteContext: resourceKeycloakRealmUserProfileAttributeDelete,
+		UpdateContext: resourceKeycloakRealmUserProfileAttributeUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmUserProfileAttributeImport,
+		},
+		Schema: attributeSchema,
+	}
+}
+
+func getRealmUserProfileAttributeFromResourceData(data *schema.ResourceData) *keycloak.RealmUserProfileAttribute {
+	m := make(map[string]interface{})
+	for key := range realmUserProfileAttributeSchema() {
+		m[key] = data.Get(key)
+	}
+
+	return getRealmUserProfileAttributeFromData(m)
+}
+
+func setRealmUserProfileAttributeResourceData(data *schema.ResourceData, attribute *keycloak.RealmUserProfileAttribute) {
+	attributeData := getRealmUserProfileAttributeData(attribute)
+
+	for key := range realmUserProfileAttributeSchema() {
+		data.Set(key, attributeData[key])
+	}
+}
+
+func resourceKeycloakRealmUserProfileAttributeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	attribute := getRealmUserProfileAttributeFromResourceData(data)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	// Built-in attributes always exist, so they are adopted. Any other attribute that already exists is owned by
+	// something else and has to be imported.
+	if !stringSliceContains(realmUserProfileBuiltInAttributes, attribute.Name) {
+		_, err := keycloakClient.GetRealmUserProfileAttribute(ctx, realmId, attribute.Name)
+		if err == nil {
+			return diag.Errorf("user profile attribute %s already exists in realm %s, it can be imported instead", attribute.Name, realmId)
+		}
+		if !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := keycloakClient.SetRealmUserProfileAttribute(ctx, realmId, attribute)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, attribute.Name))
+
+	return resourceKeycloakRealmUserProfileAttributeRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileAttributeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	attribute, err := keycloakClient.GetRealmUserProfileAttribute(ctx, realmId, name)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmUserProfileAttributeResourceData(data, attribute)
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileAttributeUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	attribute := getRealmUserProfileAttributeFromResourceData(data)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	err := keycloakClient.SetRealmUserProfileAttribute(ctx, realmId, attribute)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmUserProfileAttributeRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileAttributeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	if stringSliceContains(realmUserProfileBuiltInAttributes, name) {
+		return nil
+	}
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	err := keycloakClient.RemoveRealmUserProfileAttribute(ctx, realmId, name)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileAttributeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{attributeName}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("name", parts[1])
+	d.SetId(fmt.Sprintf("%s/%s", realmId, parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
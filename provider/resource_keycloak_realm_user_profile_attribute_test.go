@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmUserProfileAttribute_basic(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_14)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_user_profile_attribute.attribute"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfileAttribute_basic(realmName, "Department"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileAttributeExists(resourceName),
+					testAccCheckKeycloakRealmUserProfileAttributeExistsByName(realmName, "username"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Department"),
+					resource.TestCheckResourceAttr(resourceName, "multivalued", "true"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.inputType", "select"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.inputOptionLabels", `{"it":"IT","sales":"Sales"}`),
+				),
+			},
+			{
+				Config: testKeycloakRealmUserProfileAttribute_basic(realmName, "Team"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "display_name", "Team"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/department",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmUserProfileAttribute_createAfterManualDestroy(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_14)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfileAttribute_basic(realmName, "Department"),
+				Check:  testAccCheckKeycloakRealmUserProfileAttributeExists("keycloak_realm_user_profile_attribute.attribute"),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.RemoveRealmUserProfileAttribute(testCtx, realmName, "department")
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmUserProfileAttribute_basic(realmName, "Department"),
+				Check:  testAccCheckKeycloakRealmUserProfileAttributeExists("keycloak_realm_user_profile_attribute.attribute"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmUserProfileAttribute_alreadyExists(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_14)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfileAttribute_realm(realmName),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.SetRealmUserProfileAttribute(testCtx, realmName, &keycloak.RealmUserProfileAttribute{
+						Name: "department",
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:      testKeycloakRealmUserProfileAttribute_basic(realmName, "Department"),
+				ExpectError: regexp.MustCompile("user profile attribute department already exists"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmUserProfileAttributeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return testAccCheckKeycloakRealmUserProfileAttributeExistsByName(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["name"])(s)
+	}
+}
+
+func testAccCheckKeycloakRealmUserProfileAttributeExistsByName(realm, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := keycloakClient.GetRealmUserProfileAttribute(testCtx, realm, name)
+		if err != nil {
+			return fmt.Errorf("error getting user profile attribute %s: %s", name, err)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmUserProfileAttribute_basic(realm, displayName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	attributes = {
+		userProfileEnabled = true
+	}
+}
+
+resource "keycloak_realm_user_profile_attribute" "attribute" {
+	realm_id     = keycloak_realm.realm.id
+	name         = "department"
+	display_name = "%s"
+	multivalued  = true
+
+	permissions {
+		view = ["admin", "user"]
+		edit = ["admin"]
+	}
+
+	validator {
+		name   = "options"
+		config = {
+			options = jsonencode(["it", "sales"])
+		}
+	}
+
+	annotations = {
+		inputType         = "select"
+		inputOptionLabels = jsonencode({ it = "IT", sales = "Sales" })
+	}
+}
+	`, realm, displayName)
+}
+
+func testKeycloakRealmUserProfileAttribute_realm(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	attributes = {
+		userProfileEnabled = true
+	}
+}
+	`, realm)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmUserProfileGroup() *schema.Resource {
+	groupSchema := realmUserProfileGroupSchema()
+	groupSchema["name"].ForceNew = true
+	groupSchema["realm_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmUserProfileGroupCreate,
+		ReadContext:   resourceKeycloakRealmUserProfileGroupRead,
+		DeleteContext: resourceKeycloakRealmUserProfileGroupDelete,
+		UpdateContext: resourceKeycloakRealmUserProfileGroupUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmUserProfileGroupImport,
+		},
+		Schema: groupSchema,
+	}
+}
+
+func getRealmUserProfileGroupFromResourceData(data *schema.ResourceData) *keycloak.RealmUserProfileGroup {
+	m := make(map[string]interface{})
+	for key := range realmUserProfileGroupSchema() {
+		m[key] = data.Get(key)
+	}
+
+	return getRealmUserProfileGroupFromData(m)
+}
+
+func setRealmUserProfileGroupResourceData(data *schema.ResourceData, group *keycloak.RealmUserProfileGroup) {
+	groupData := getRealmUserProfileGroupData(group)
+
+	for key := range realmUserProfileGroupSchema() {
+		data.Set(key, groupData[key])
+	}
+}
+
+func resourceKeycloakRealmUserProfileGroupCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	data.SetId(fmt.Sprintf("%s/%s", data.Get("realm_id").(string), data.Get("name").(string)))
+
+	return resourceKeycloakRealmUserProfileGroupUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileGroupRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	group, err := keycloakClient.GetRealmUserProfileGroup(ctx, realmId, name)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmUserProfileGroupResourceData(data, group)
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileGroupUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	group := getRealmUserProfileGroupFromResourceData(data)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	err := keycloakClient.SetRealmUserProfileGroup(ctx, realmId, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmUserProfileGroupRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileGroupDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	err := keycloakClient.RemoveRealmUserProfileGroup(ctx, realmId, name)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidImportError("{{realm}}/{{groupName}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("name", parts[1])
+	d.SetId(fmt.Sprintf("%s/%s", realmId, parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmUserProfileGroup_basic(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_14)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_user_profile_group.group"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfileGroup_basic(realmName, "Work"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileGroupExists(resourceName),
+					testAccCheckKeycloakRealmUserProfileAttributeExists("keycloak_realm_user_profile_attribute.attribute"),
+					resource.TestCheckResourceAttr(resourceName, "display_header", "Work"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.foo", "bar"),
+				),
+			},
+			{
+				Config: testKeycloakRealmUserProfileGroup_basic(realmName, "Employment"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "display_header", "Employment"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/work",
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmUserProfileGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		name := rs.Primary.Attributes["name"]
+
+		_, err := keycloakClient.GetRealmUserProfileGroup(testCtx, realm, name)
+		if err != nil {
+			return fmt.Errorf("error getting user profile group %s: %s", name, err)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmUserProfileGroup_basic(realm, displayHeader string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	attributes = {
+		userProfileEnabled = true
+	}
+}
+
+resource "keycloak_realm_user_profile_group" "group" {
+	realm_id            = keycloak_realm.realm.id
+	name                = "work"
+	display_header      = "%s"
+	display_description = "Information about your work"
+
+	annotations = {
+		foo = "bar"
+	}
+}
+
+resource "keycloak_realm_user_profile_attribute" "attribute" {
+	realm_id = keycloak_realm.realm.id
+	name     = "employer"
+	group    = keycloak_realm_user_profile_group.group.name
+}
+	`, realm, displayHeader)
+}
@@ -48,6 +48,12 @@ func TestAccKeycloakRealmUserProfile_basicEmpty(t *testing.T) {
 				Config: testKeycloakRealmUserProfile_template(realmName, realmUserProfile),
 				Check:  testAccCheckKeycloakRealmUserProfileExists("keycloak_realm_user_profile.realm_user_profile"),
 			},
+			{
+				ResourceName:      "keycloak_realm_user_profile.realm_user_profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
 		},
 	})
 }
@@ -346,6 +352,73 @@ func TestAccKeycloakRealmUserProfile_attributePermissions(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealmUserProfile_unmanagedAttributePolicy(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_24); !ok {
+		t.Skip("keycloak server version is less than 24, skipping...")
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmUserProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfile_unmanagedAttributePolicy(realmName, "ADMIN_VIEW"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_user_profile.realm_user_profile", "unmanaged_attribute_policy", "ADMIN_VIEW"),
+			},
+			{
+				Config: testKeycloakRealmUserProfile_unmanagedAttributePolicy(realmName, "ENABLED"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_user_profile.realm_user_profile", "unmanaged_attribute_policy", "ENABLED"),
+			},
+			{
+				ResourceName:      "keycloak_realm_user_profile.realm_user_profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmUserProfile_unmanagedAttributePolicyInvalid(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmUserProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmUserProfile_unmanagedAttributePolicy(realmName, "DISABLED"),
+				ExpectError: regexp.MustCompile("expected unmanaged_attribute_policy to be one of"),
+			},
+		},
+	})
+}
+
+func testKeycloakRealmUserProfile_unmanagedAttributePolicy(realm, policy string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_user_profile" "realm_user_profile" {
+	realm_id                   = keycloak_realm.realm.id
+	unmanaged_attribute_policy = "%s"
+
+	attribute {
+		name = "username"
+	}
+
+	attribute {
+		name = "email"
+	}
+}
+`, realm, policy)
+}
+
 func testKeycloakRealmUserProfile_featureDisabled(realm string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
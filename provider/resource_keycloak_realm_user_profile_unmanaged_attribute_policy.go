@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmUserProfileUnmanagedAttributePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmUserProfileUnmanagedAttributePolicyCreate,
+		ReadContext:   resourceKeycloakRealmUserProfileUnmanagedAttributePolicyRead,
+		DeleteContext: resourceKeycloakRealmUserProfileUnmanagedAttributePolicyDelete,
+		UpdateContext: resourceKeycloakRealmUserProfileUnmanagedAttributePolicyUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmUserProfileUnmanagedAttributePolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"unmanaged_attribute_policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "ADMIN_EDIT", "ADMIN_VIEW"}, false),
+				Description:  "The policy for attributes that are not part of the user profile.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakRealmUserProfileUnmanagedAttributePolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmUserProfileUnmanagedAttributePolicyUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileUnmanagedAttributePolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	realmUserProfile, err := keycloakClient.GetRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// the policy was disabled outside of Terraform, so it has to be set again
+	if realmUserProfile.UnmanagedAttributePolicy == "" {
+		data.SetId("")
+		return nil
+	}
+
+	data.Set("unmanaged_attribute_policy", realmUserProfile.UnmanagedAttributePolicy)
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileUnmanagedAttributePolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	policy := data.Get("unmanaged_attribute_policy").(string)
+
+	err := checkRealmUserProfileUnmanagedAttributePolicySupported(ctx, keycloakClient, &keycloak.RealmUserProfile{UnmanagedAttributePolicy: policy})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	err = keycloakClient.SetRealmUserProfileUnmanagedAttributePolicy(ctx, realmId, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmUserProfileUnmanagedAttributePolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileUnmanagedAttributePolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	err := keycloakClient.SetRealmUserProfileUnmanagedAttributePolicy(ctx, realmId, "")
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileUnmanagedAttributePolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.SetId(realmId)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmUserProfileUnmanagedAttributePolicy_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_24); !ok {
+		t.Skip("keycloak server version is less than 24, skipping...")
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_user_profile_unmanaged_attribute_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfileUnmanagedAttributePolicy_basic(realmName, "ADMIN_VIEW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileUnmanagedAttributePolicy(realmName, "ADMIN_VIEW"),
+					testAccCheckKeycloakRealmUserProfileAttributeExists("keycloak_realm_user_profile_attribute.attribute"),
+				),
+			},
+			{
+				Config: testKeycloakRealmUserProfileUnmanagedAttributePolicy_basic(realmName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileUnmanagedAttributePolicy(realmName, "ENABLED"),
+					testAccCheckKeycloakRealmUserProfileAttributeExists("keycloak_realm_user_profile_attribute.attribute"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
+			// removing the resource disables unmanaged attributes, but keeps the rest of the user profile
+			{
+				Config: testKeycloakRealmUserProfileUnmanagedAttributePolicy_withoutPolicy(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileUnmanagedAttributePolicy(realmName, ""),
+					testAccCheckKeycloakRealmUserProfileAttributeExists("keycloak_realm_user_profile_attribute.attribute"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmUserProfileUnmanagedAttributePolicy(realm, policy string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		realmUserProfile, err := keycloakClient.GetRealmUserProfile(testCtx, realm)
+		if err != nil {
+			return err
+		}
+
+		if realmUserProfile.UnmanagedAttributePolicy != policy {
+			return fmt.Errorf("expected user profile of realm %s to have unmanaged attribute policy %q, but was %q", realm, policy, realmUserProfile.UnmanagedAttributePolicy)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmUserProfileUnmanagedAttributePolicy_withoutPolicy(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_user_profile_attribute" "attribute" {
+	realm_id = keycloak_realm.realm.id
+	name     = "employer"
+}
+	`, realm)
+}
+
+func testKeycloakRealmUserProfileUnmanagedAttributePolicy_basic(realm, policy string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_realm_user_profile_unmanaged_attribute_policy" "policy" {
+	realm_id                   = keycloak_realm.realm.id
+	unmanaged_attribute_policy = "%s"
+
+	depends_on = [keycloak_realm_user_profile_attribute.attribute]
+}
+	`, testKeycloakRealmUserProfileUnmanagedAttributePolicy_withoutPolicy(realm), policy)
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmWebAuthnPolicy() *schema.Resource {
+	webAuthnPolicySchema := realmWebAuthnPolicySchema()
+	webAuthnPolicySchema["realm_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	webAuthnPolicySchema["passwordless"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "When true, the WebAuthn Passwordless policy of the realm is managed instead of the WebAuthn policy.",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmWebAuthnPolicyCreate,
+		ReadContext:   resourceKeycloakRealmWebAuthnPolicyRead,
+		DeleteContext: resourceKeycloakRealmWebAuthnPolicyDelete,
+		UpdateContext: resourceKeycloakRealmWebAuthnPolicyUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmWebAuthnPolicyImport,
+		},
+		Schema: webAuthnPolicySchema,
+	}
+}
+
+func getRealmWebAuthnPolicyFieldsFromData(data *schema.ResourceData) []string {
+	if data.Get("passwordless").(bool) {
+		return keycloak.RealmWebAuthnPasswordlessPolicyFields
+	}
+
+	return keycloak.RealmWebAuthnPolicyFields
+}
+
+func setRealmWebAuthnPolicyFromData(data *schema.ResourceData, realm *keycloak.Realm, webAuthnPolicy map[string]interface{}) {
+	if data.Get("passwordless").(bool) {
+		setRealmWebAuthnPasswordlessPolicyFromSettings(realm, webAuthnPolicy)
+	} else {
+		setRealmWebAuthnPolicyFromSettings(realm, webAuthnPolicy)
+	}
+}
+
+func setRealmWebAuthnPolicyData(data *schema.ResourceData, realm *keycloak.Realm) {
+	webAuthnPolicy := getRealmWebAuthnPolicySettings(realm)
+	if data.Get("passwordless").(bool) {
+		webAuthnPolicy = getRealmWebAuthnPasswordlessPolicySettings(realm)
+	}
+
+	for key, value := range webAuthnPolicy {
+		data.Set(key, value)
+	}
+}
+
+func resourceKeycloakRealmWebAuthnPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	realmId := data.Get("realm_id").(string)
+
+	if data.Get("passwordless").(bool) {
+		data.SetId(fmt.Sprintf("%s/passwordless", realmId))
+	} else {
+		data.SetId(realmId)
+	}
+
+	return resourceKeycloakRealmWebAuthnPolicyUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRealmWebAuthnPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmWebAuthnPolicyData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakRealmWebAuthnPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	webAuthnPolicy := make(map[string]interface{})
+	for key := range realmWebAuthnPolicySchema() {
+		webAuthnPolicy[key] = data.Get(key)
+	}
+
+	realm := &keycloak.Realm{
+		Realm: realmId,
+	}
+	setRealmWebAuthnPolicyFromData(data, realm, webAuthnPolicy)
+
+	err := keycloakClient.UpdateRealmFields(ctx, realm, getRealmWebAuthnPolicyFieldsFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmWebAuthnPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmWebAuthnPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	mutexKey := realmMutexKey(realmId)
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
+	// The WebAuthn policy cannot be deleted, so instead it is set back to its defaults
+	webAuthnPolicy := getDefaultSettings(realmWebAuthnPolicySchema())
+	webAuthnPolicy["signature_algorithms"] = schema.NewSet(schema.HashString, []interface{}{"ES256"})
+
+	realm := &keycloak.Realm{
+		Realm: realmId,
+	}
+	setRealmWebAuthnPolicyFromData(data, realm, webAuthnPolicy)
+
+	return diag.FromErr(keycloakClient.UpdateRealmFields(ctx, realm, getRealmWebAuthnPolicyFieldsFromData(data)))
+}
+
+func resourceKeycloakRealmWebAuthnPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if parts[0] == "" || len(parts) > 2 || (len(parts) == 2 && parts[1] != "passwordless") {
+		return nil, invalidImportError("{{realm}}", "{{realm}}/passwordless")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("passwordless", len(parts) == 2)
+
+	if len(parts) == 2 {
+		d.SetId(fmt.Sprintf("%s/passwordless", realmId))
+	} else {
+		d.SetId(realmId)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
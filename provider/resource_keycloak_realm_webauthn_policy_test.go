@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmWebAuthnPolicy_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmWebAuthnPolicy_basic(realmName, "foo", "bar"),
+				Check:  testAccCheckKeycloakRealmWebAuthnPolicies(realmName, "foo", "bar"),
+			},
+			{
+				ResourceName:      "keycloak_realm_webauthn_policy.webauthn_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
+			{
+				ResourceName:      "keycloak_realm_webauthn_policy.webauthn_passwordless_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/passwordless",
+			},
+			{
+				Config: testKeycloakRealmWebAuthnPolicy_basic(realmName, "bar", "foo"),
+				Check:  testAccCheckKeycloakRealmWebAuthnPolicies(realmName, "bar", "foo"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmWebAuthnPolicies(realmName, rpEntityName, passwordlessRpEntityName string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		realm, err := keycloakClient.GetRealm(testCtx, realmName)
+		if err != nil {
+			return err
+		}
+
+		if realm.WebAuthnPolicyRpEntityName != rpEntityName {
+			return fmt.Errorf("expected realm %s to have webauthn relying party entity name set to %s, but was %s", realmName, rpEntityName, realm.WebAuthnPolicyRpEntityName)
+		}
+
+		if realm.WebAuthnPolicyPasswordlessRpEntityName != passwordlessRpEntityName {
+			return fmt.Errorf("expected realm %s to have webauthn passwordless relying party entity name set to %s, but was %s", realmName, passwordlessRpEntityName, realm.WebAuthnPolicyPasswordlessRpEntityName)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmWebAuthnPolicy_basic(realm, rpEntityName, passwordlessRpEntityName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_webauthn_policy" "webauthn_policy" {
+	realm_id                  = keycloak_realm.realm.id
+	relying_party_entity_name = "%s"
+	signature_algorithms      = ["ES256", "RS256"]
+}
+
+resource "keycloak_realm_webauthn_policy" "webauthn_passwordless_policy" {
+	realm_id                  = keycloak_realm.realm.id
+	passwordless              = true
+	relying_party_entity_name = "%s"
+	signature_algorithms      = ["ES256"]
+}
+	`, realm, rpEntityName, passwordlessRpEntityName)
+}
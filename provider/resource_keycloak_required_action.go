@@ -21,6 +21,7 @@ func resourceKeycloakRequiredAction() *schema.Resource {
 			// This resource can be imported using {{realm}}/{{alias}}. The required action aliases are displayed in the server info or GET realms/{{realm}}/authentication/required-actions
 			StateContext: resourceKeycloakRequiredActionsImport,
 		},
+		CustomizeDiff: customizeDiffValidateServerInfo("alias", validateProviderInstalled("required action", "required-action")),
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -17,6 +17,7 @@ func resourceKeycloakRole() *schema.Resource {
 		ReadContext:   resourceKeycloakRoleRead,
 		DeleteContext: resourceKeycloakRoleDelete,
 		UpdateContext: resourceKeycloakRoleUpdate,
+		CustomizeDiff: customizeDiffValidateMultivaluedAttributes,
 		// This resource can be imported using {{realm}}/{{roleId}}. The role's ID (a GUID) can be found in the URL when viewing the role
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakRoleImport,
@@ -52,42 +53,32 @@ func resourceKeycloakRole() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"multivalued_attributes": multivaluedAttributesSchema(),
 		},
 	}
 }
 
 func mapFromDataToRole(data *schema.ResourceData) *keycloak.Role {
-	attributes := map[string][]string{}
-	if v, ok := data.GetOk("attributes"); ok {
-		for key, value := range v.(map[string]interface{}) {
-			attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
-		}
-	}
-
 	role := &keycloak.Role{
 		Id:          data.Id(),
 		RealmId:     data.Get("realm_id").(string),
 		ClientId:    data.Get("client_id").(string),
 		Name:        data.Get("name").(string),
 		Description: data.Get("description").(string),
-		Attributes:  attributes,
+		Attributes:  getAttributesFromData(data),
 	}
 
 	return role
 }
 
 func mapFromRoleToData(data *schema.ResourceData, role *keycloak.Role) {
-	attributes := map[string]string{}
-	for k, v := range role.Attributes {
-		attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
-	}
 	data.SetId(role.Id)
 
 	data.Set("realm_id", role.RealmId)
 	data.Set("client_id", role.ClientId)
 	data.Set("name", role.Name)
 	data.Set("description", role.Description)
-	data.Set("attributes", attributes)
+	setAttributesData(data, role.Attributes)
 }
 
 func resourceKeycloakRoleCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
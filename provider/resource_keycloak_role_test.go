@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -292,6 +293,28 @@ func TestAccKeycloakRole_basicWithAttributes(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRole_multivaluedAttributes(t *testing.T) {
+	t.Parallel()
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRole_multivaluedAttributes(roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleHasAttributeValues("keycloak_role.role", "list", []string{"a##b", "c"}),
+					testAccCheckKeycloakRoleHasAttributeValues("keycloak_role.role", "joined", []string{"d", "e"}),
+					resource.TestCheckResourceAttr("keycloak_role.role", "attributes.%", "1"),
+					resource.TestCheckResourceAttr("keycloak_role.role", "multivalued_attributes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakRoleExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getRoleFromState(s, resourceName)
@@ -354,6 +377,21 @@ func testAccCheckKeycloakRoleHasAttribute(resourceName, attributeName, attribute
 	}
 }
 
+func testAccCheckKeycloakRoleHasAttributeValues(resourceName, attributeName string, attributeValues []string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		role, err := getRoleFromState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !reflect.DeepEqual(role.Attributes[attributeName], attributeValues) {
+			return fmt.Errorf("expected role %s to have attribute %s with values %v, but got %v", role.Name, attributeName, attributeValues, role.Attributes[attributeName])
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakRoleHasComposites(resourceName string, compositeRoleNames []string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		role, err := getRoleFromState(state, resourceName)
@@ -579,3 +617,25 @@ resource "keycloak_role" "role" {
 }
 	`, testAccRealm.Realm, role, attributeName, attributeValue)
 }
+
+func testKeycloakRole_multivaluedAttributes(role string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	attributes = {
+		joined = "d##e"
+	}
+
+	multivalued_attributes {
+		name   = "list"
+		values = ["a##b", "c"]
+	}
+}
+	`, testAccRealm.Realm, role)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak/types"
 	"reflect"
 	"strings"
@@ -38,13 +39,18 @@ func resourceKeycloakSamlClient() *schema.Resource {
 		UpdateContext: resourceKeycloakSamlClientUpdate,
 		// This resource can be imported using {{realm}}/{{client_id}}. The Client ID is displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceKeycloakSamlClientImport,
+			StateContext: importWithDeletionProtection(resourceKeycloakSamlClientImport),
 		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffValidateServerInfo("login_theme", validateThemeInstalled("login")),
+			customizeDiffDeletionProtection,
+		),
 		Schema: map[string]*schema.Schema{
 			"client_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 			"realm_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -463,6 +469,10 @@ func resourceKeycloakSamlClientUpdate(ctx context.Context, data *schema.Resource
 }
 
 func resourceKeycloakSamlClientDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(data, "saml client"); diags.HasError() {
+		return diags
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -15,6 +15,9 @@ func resourceKeycloakSamlClientDefaultScopes() *schema.Resource {
 		ReadContext:   resourceKeycloakSamlClientDefaultScopesRead,
 		DeleteContext: resourceKeycloakSamlClientDefaultScopesDelete,
 		UpdateContext: resourceKeycloakSamlClientDefaultScopesUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakClientScopesImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -38,6 +41,11 @@ func resourceKeycloakSamlClientDefaultScopes() *schema.Resource {
 
 func resourceKeycloakSamlClientDefaultScopesCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	mutexKey := clientMutexKey(data.Get("realm_id").(string), data.Get("client_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	defaultScopes := data.Get("default_scopes").(*schema.Set)
@@ -81,6 +89,10 @@ func resourceKeycloakSamlClientDefaultScopesRead(ctx context.Context, data *sche
 func resourceKeycloakSamlClientDefaultScopesUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := clientMutexKey(data.Get("realm_id").(string), data.Get("client_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	tfSamlClientDefaultScopes := data.Get("default_scopes").(*schema.Set)
@@ -122,6 +134,10 @@ func resourceKeycloakSamlClientDefaultScopesUpdate(ctx context.Context, data *sc
 func resourceKeycloakSamlClientDefaultScopesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	mutexKey := clientMutexKey(data.Get("realm_id").(string), data.Get("client_id").(string))
+	keycloakMutexKV.Lock(mutexKey)
+	defer keycloakMutexKV.Unlock(mutexKey)
+
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	defaultScopes := data.Get("default_scopes").(*schema.Set)
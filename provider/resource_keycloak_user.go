@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 )
 
@@ -19,6 +20,7 @@ func resourceKeycloakUser() *schema.Resource {
 		ReadContext:   resourceKeycloakUserRead,
 		DeleteContext: resourceKeycloakUserDelete,
 		UpdateContext: resourceKeycloakUserUpdate,
+		CustomizeDiff: customizeDiffValidateMultivaluedAttributes,
 		// This resource can be imported using {{realm}}/{{user_id}}. The User's ID is displayed in the GUI when editing
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakUserImport,
@@ -64,11 +66,21 @@ func resourceKeycloakUser() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"multivalued_attributes": multivaluedAttributesSchema(),
 			"required_actions": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
 			},
+			"federation_link": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the user federation provider the user is linked to.",
+			},
+			// Only the links to the identity providers that are configured here are managed, so links created outside of this
+			// resource are neither read nor removed.
 			"federated_identity": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -109,6 +121,102 @@ func resourceKeycloakUser() *schema.Resource {
 					},
 				},
 			},
+			"credential": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: onlyDiffOnCreate,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{keycloak.UserCredentialTypePassword, keycloak.UserCredentialTypeOtp}, false),
+						},
+						"user_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"temporary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"hashed_password": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"value": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+									"salt": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										Sensitive: true,
+									},
+									"algorithm": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"hash_iterations": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"otp": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"secret": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Sensitive:   true,
+										Description: "The raw OTP secret. Keycloak uses its UTF-8 bytes as the HMAC key, so base32 encoded secrets must be decoded first.",
+									},
+									"sub_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "totp",
+										ValidateFunc: validation.StringInSlice([]string{"totp", "hotp"}, false),
+									},
+									"algorithm": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "HmacSHA1",
+										ValidateFunc: validation.StringInSlice([]string{"HmacSHA1", "HmacSHA256", "HmacSHA512"}, false),
+									},
+									"digits": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      6,
+										ValidateFunc: validation.IntInSlice([]int{6, 8}),
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      30,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"counter": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -123,7 +231,6 @@ func onlyDiffOnCreate(_, _, _ string, d *schema.ResourceData) bool {
 }
 
 func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
-	attributes := map[string][]string{}
 	var requiredActions []string
 
 	if v, ok := data.GetOk("required_actions"); ok {
@@ -131,12 +238,6 @@ func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
 			requiredActions = append(requiredActions, requiredAction.(string))
 		}
 	}
-	if v, ok := data.GetOk("attributes"); ok {
-		for key, value := range v.(map[string]interface{}) {
-			attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
-		}
-	}
-
 	federatedIdentities := &keycloak.FederatedIdentities{}
 
 	if v, ok := data.GetOk("federated_identity"); ok {
@@ -152,12 +253,65 @@ func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
 		FirstName:           data.Get("first_name").(string),
 		LastName:            data.Get("last_name").(string),
 		Enabled:             data.Get("enabled").(bool),
-		Attributes:          attributes,
+		FederationLink:      data.Get("federation_link").(string),
+		Attributes:          getAttributesFromData(data),
 		FederatedIdentities: *federatedIdentities,
 		RequiredActions:     requiredActions,
 	}
 }
 
+// Credentials are only sent when the user is created, as Keycloak never returns their secret data.
+func getUserCredentialsFromData(data *schema.ResourceData) ([]*keycloak.UserCredential, error) {
+	var credentials []*keycloak.UserCredential
+
+	for _, c := range data.Get("credential").([]interface{}) {
+		credentialData := c.(map[string]interface{})
+		credentialType := credentialData["type"].(string)
+		userLabel := credentialData["user_label"].(string)
+		hashedPasswords := credentialData["hashed_password"].([]interface{})
+		otps := credentialData["otp"].([]interface{})
+
+		var credential *keycloak.UserCredential
+		var err error
+
+		switch credentialType {
+		case keycloak.UserCredentialTypePassword:
+			if len(hashedPasswords) == 0 || len(otps) != 0 {
+				return nil, fmt.Errorf("validation error: a credential of type %s must have a hashed_password block and no otp block", credentialType)
+			}
+
+			hashedPassword := hashedPasswords[0].(map[string]interface{})
+			credential, err = keycloak.NewUserHashedPasswordCredential(&keycloak.UserHashedPassword{
+				Value:          hashedPassword["value"].(string),
+				Salt:           hashedPassword["salt"].(string),
+				Algorithm:      hashedPassword["algorithm"].(string),
+				HashIterations: hashedPassword["hash_iterations"].(int),
+			}, userLabel, credentialData["temporary"].(bool))
+		case keycloak.UserCredentialTypeOtp:
+			if len(otps) == 0 || len(hashedPasswords) != 0 {
+				return nil, fmt.Errorf("validation error: a credential of type %s must have an otp block and no hashed_password block", credentialType)
+			}
+
+			otp := otps[0].(map[string]interface{})
+			credential, err = keycloak.NewUserOtpCredential(&keycloak.UserOtpSecret{
+				Secret:    otp["secret"].(string),
+				SubType:   otp["sub_type"].(string),
+				Algorithm: otp["algorithm"].(string),
+				Digits:    otp["digits"].(int),
+				Period:    otp["period"].(int),
+				Counter:   otp["counter"].(int),
+			}, userLabel)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		credentials = append(credentials, credential)
+	}
+
+	return credentials, nil
+}
+
 func getUserFederatedIdentitiesFromData(data []interface{}) *keycloak.FederatedIdentities {
 	var federatedIdentities keycloak.FederatedIdentities
 	for _, d := range data {
@@ -182,10 +336,6 @@ func mapFromUserToData(data *schema.ResourceData, user *keycloak.User) {
 		}
 		federatedIdentities = append(federatedIdentities, identity)
 	}
-	attributes := map[string]string{}
-	for k, v := range user.Attributes {
-		attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
-	}
 	data.SetId(user.Id)
 	data.Set("realm_id", user.RealmId)
 	data.Set("username", user.Username)
@@ -194,7 +344,8 @@ func mapFromUserToData(data *schema.ResourceData, user *keycloak.User) {
 	data.Set("first_name", user.FirstName)
 	data.Set("last_name", user.LastName)
 	data.Set("enabled", user.Enabled)
-	data.Set("attributes", attributes)
+	data.Set("federation_link", user.FederationLink)
+	setAttributesData(data, user.Attributes)
 	data.Set("federated_identity", federatedIdentities)
 	data.Set("required_actions", user.RequiredActions)
 }
@@ -204,7 +355,13 @@ func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData,
 
 	user := mapFromDataToUser(data)
 
-	err := keycloakClient.NewUser(ctx, user)
+	credentials, err := getUserCredentialsFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	user.Credentials = credentials
+
+	err = keycloakClient.NewUser(ctx, user)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -236,11 +393,31 @@ func resourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData, me
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	user.FederatedIdentities = getManagedUserFederatedIdentities(data, user.FederatedIdentities)
+
 	mapFromUserToData(data, user)
 
 	return nil
 }
 
+// getManagedUserFederatedIdentities returns the links to the identity providers of the federated_identity blocks in the
+// state.
+func getManagedUserFederatedIdentities(data *schema.ResourceData, federatedIdentities keycloak.FederatedIdentities) keycloak.FederatedIdentities {
+	managedIdentityProviders := make(map[string]bool)
+	for _, federatedIdentity := range *getUserFederatedIdentitiesFromData(data.Get("federated_identity").(*schema.Set).List()) {
+		managedIdentityProviders[federatedIdentity.IdentityProvider] = true
+	}
+
+	var managedFederatedIdentities keycloak.FederatedIdentities
+	for _, federatedIdentity := range federatedIdentities {
+		if managedIdentityProviders[federatedIdentity.IdentityProvider] {
+			managedFederatedIdentities = append(managedFederatedIdentities, federatedIdentity)
+		}
+	}
+
+	return managedFederatedIdentities
+}
+
 func resourceKeycloakUserUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -251,6 +428,21 @@ func resourceKeycloakUserUpdate(ctx context.Context, data *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if data.HasChange("federated_identity") {
+		oldFederatedIdentities, newFederatedIdentities := data.GetChange("federated_identity")
+
+		err = keycloakClient.UpdateUserFederatedIdentities(
+			ctx,
+			user.RealmId,
+			user.Id,
+			*getUserFederatedIdentitiesFromData(oldFederatedIdentities.(*schema.Set).List()),
+			*getUserFederatedIdentitiesFromData(newFederatedIdentities.(*schema.Set).List()),
+		)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	mapFromUserToData(data, user)
 
 	return nil
@@ -273,7 +465,7 @@ func resourceKeycloakUserImport(ctx context.Context, d *schema.ResourceData, met
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{userId}}")
 	}
 
-	_, err := keycloakClient.GetUser(ctx, parts[0], parts[1])
+	user, err := keycloakClient.GetUser(ctx, parts[0], parts[1])
 	if err != nil {
 		return nil, err
 	}
@@ -281,6 +473,9 @@ func resourceKeycloakUserImport(ctx context.Context, d *schema.ResourceData, met
 	d.Set("realm_id", parts[0])
 	d.SetId(parts[1])
 
+	// all links of the user are imported, since there is no configuration yet to tell which of them are managed
+	mapFromUserToData(d, user)
+
 	diagnostics := resourceKeycloakUserRead(ctx, d, meta)
 	if diagnostics.HasError() {
 		return nil, errors.New(diagnostics[0].Summary)
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// Keycloak cannot update the link of a user to an identity provider, so every change forces a new link to be created.
+func resourceKeycloakUserFederatedIdentity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserFederatedIdentityCreate,
+		ReadContext:   resourceKeycloakUserFederatedIdentityRead,
+		DeleteContext: resourceKeycloakUserFederatedIdentityDelete,
+		// This resource can be imported using {{realm}}/{{userId}}/{{identityProviderAlias}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakUserFederatedIdentityImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_provider": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The alias of the identity provider.",
+			},
+			"federated_user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the user in the identity provider.",
+			},
+			"federated_username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the user in the identity provider.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakUserFederatedIdentityCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	err := keycloakClient.NewUserFederatedIdentity(ctx, realmId, userId, &keycloak.FederatedIdentity{
+		IdentityProvider: identityProvider,
+		UserId:           data.Get("federated_user_id").(string),
+		UserName:         data.Get("federated_username").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", userId, identityProvider))
+
+	return resourceKeycloakUserFederatedIdentityRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserFederatedIdentityRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	federatedIdentity, err := keycloakClient.GetUserFederatedIdentity(ctx, data.Get("realm_id").(string), data.Get("user_id").(string), data.Get("identity_provider").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("federated_user_id", federatedIdentity.UserId)
+	data.Set("federated_username", federatedIdentity.UserName)
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DeleteUserFederatedIdentity(ctx, data.Get("realm_id").(string), data.Get("user_id").(string), data.Get("identity_provider").(string))
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, invalidImportError("{{realm}}/{{userId}}/{{identityProviderAlias}}")
+	}
+
+	realmId, err := resolveImportRealmName(ctx, keycloakClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = keycloakClient.GetUserFederatedIdentity(ctx, realmId, parts[1], parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("user_id", parts[1])
+	d.Set("identity_provider", parts[2])
+	d.SetId(fmt.Sprintf("%s/%s", parts[1], parts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
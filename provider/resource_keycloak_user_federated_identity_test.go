@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakUserFederatedIdentity_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user_federated_identity.link"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(realmName, username, "remote-id", "remote-user"),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, "remote-id", "remote-user"),
+			},
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(realmName, username, "remote-id", "remote-user-renamed"),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, "remote-id", "remote-user-renamed"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getUserFederatedIdentityImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserFederatedIdentity_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user_federated_identity.link"
+	var userId, identityProvider string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(realmName, username, "remote-id", "remote-user"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserFederatedIdentityExists(resourceName, "remote-id", "remote-user"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources[resourceName]
+						userId = rs.Primary.Attributes["user_id"]
+						identityProvider = rs.Primary.Attributes["identity_provider"]
+
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteUserFederatedIdentity(testCtx, realmName, userId, identityProvider)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserFederatedIdentity_basic(realmName, username, "remote-id", "remote-user"),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, "remote-id", "remote-user"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserFederatedIdentityExists(resourceName, federatedUserId, federatedUsername string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		federatedIdentity, err := keycloakClient.GetUserFederatedIdentity(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["user_id"], rs.Primary.Attributes["identity_provider"])
+		if err != nil {
+			return err
+		}
+
+		if federatedIdentity.UserId != federatedUserId || federatedIdentity.UserName != federatedUsername {
+			return fmt.Errorf("expected federated identity with user id %s and username %s, but got user id %s and username %s", federatedUserId, federatedUsername, federatedIdentity.UserId, federatedIdentity.UserName)
+		}
+
+		return nil
+	}
+}
+
+func getUserFederatedIdentityImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["user_id"], rs.Primary.Attributes["identity_provider"]), nil
+	}
+}
+
+func testKeycloakUserFederatedIdentity_basic(realm, username, federatedUserId, federatedUsername string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "idp" {
+	realm             = keycloak_realm.realm.id
+	alias             = "remote"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "client"
+	client_secret     = "secret"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_federated_identity" "link" {
+	realm_id           = keycloak_realm.realm.id
+	user_id            = keycloak_user.user.id
+	identity_provider  = keycloak_oidc_identity_provider.idp.alias
+	federated_user_id  = "%s"
+	federated_username = "%s"
+}
+	`, realm, username, federatedUserId, federatedUsername)
+}
@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/binary"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,6 +16,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestAccKeycloakUser_basic(t *testing.T) {
@@ -66,6 +70,87 @@ func TestAccKeycloakUser_withInitialPassword(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_withCredentials(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user.user"
+	otpResourceName := "keycloak_user.user_with_otp"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_credentials(username, clientId, "imported"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserExists(resourceName),
+					testAccCheckKeycloakUserHasCredentials(resourceName, keycloak.UserCredentialTypePassword),
+					testAccCheckKeycloakUserInitialPasswordLogin(username, "tf-acc-hashed-password", clientId),
+					testAccCheckKeycloakUserExists(otpResourceName),
+					testAccCheckKeycloakUserHasCredentials(otpResourceName, keycloak.UserCredentialTypePassword, keycloak.UserCredentialTypeOtp),
+					testAccCheckKeycloakUserOtpLogin(username+"-otp", "tf-acc-hashed-password", "tf-acc-otp-secret-1234", clientId),
+				),
+			},
+			// credentials are only set on creation, so changing them should not cause a diff
+			{
+				Config:   testKeycloakUser_credentials(username, clientId, "updated"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUser_credentialsValidation(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUser_credentialWithoutHashedPassword(username),
+				ExpectError: regexp.MustCompile("a credential of type password must have a hashed_password block"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUser_multivaluedAttributes(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_multivaluedAttributes(username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserExists("keycloak_user.user"),
+					resource.TestCheckResourceAttr("keycloak_user.user", "attributes.%", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("keycloak_user.user", "multivalued_attributes.*", map[string]string{
+						"name":     "list",
+						"values.#": "2",
+						"values.0": "a##b",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_user.user", "multivalued_attributes.*", map[string]string{
+						"name":     "list",
+						"values.#": "2",
+						"values.0": "a##b",
+					}),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "attributes.%", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakUser_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 	var user = &keycloak.User{}
@@ -287,6 +372,73 @@ func TestAccKeycloakUser_federatedLink(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_federatedIdentities(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user.user"
+	var userId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_federatedIdentities(realmName, username, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserFederatedIdentityProviders(resourceName, "remote-a"),
+					func(s *terraform.State) error {
+						userId = s.RootModule().Resources[resourceName].Primary.ID
+
+						return nil
+					},
+				),
+			},
+			// links to other identity providers are not managed by the resource, so they are neither planned nor removed
+			{
+				PreConfig: func() {
+					err := keycloakClient.NewUserFederatedIdentity(testCtx, realmName, userId, &keycloak.FederatedIdentity{
+						IdentityProvider: "remote-b",
+						UserId:           "remote-id",
+						UserName:         "remote-user",
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testKeycloakUser_federatedIdentities(realmName, username, true),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakUser_federatedIdentities(realmName, username, false),
+				Check:  testAccCheckKeycloakUserFederatedIdentityProviders(resourceName, "remote-b"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserFederatedIdentityProviders(resourceName string, identityProviders ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		var linkedIdentityProviders []string
+		for _, federatedIdentity := range user.FederatedIdentities {
+			linkedIdentityProviders = append(linkedIdentityProviders, federatedIdentity.IdentityProvider)
+		}
+
+		if strings.Join(linkedIdentityProviders, ",") != strings.Join(identityProviders, ",") {
+			return fmt.Errorf("expected user %s to be linked to identity providers %v, but was linked to %v", user.Username, identityProviders, linkedIdentityProviders)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakUserHasFederationLinkWithSourceUserName(resourceName, sourceUserName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		fetchedUser, err := getUserFromState(s, resourceName)
@@ -370,6 +522,84 @@ func testAccCheckKeycloakUserInitialPasswordLogin(username, password, clientId s
 	}
 }
 
+// testAccCheckKeycloakUserOtpLogin logs in with the password grant and a TOTP code generated from the raw secret, the
+// same way an authenticator app does, using the default OTP settings of a credential.
+func testAccCheckKeycloakUserOtpLogin(username, password, secret, clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceUrl := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm)
+
+		form := url.Values{}
+		form.Add("username", username)
+		form.Add("password", password)
+		form.Add("totp", generateTotpCode([]byte(secret), time.Now(), 30, 6))
+		form.Add("client_id", clientId)
+		form.Add("grant_type", "password")
+
+		response, err := http.PostForm(resourceUrl, form)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			body, _ := ioutil.ReadAll(response.Body)
+			return fmt.Errorf("user with username %s cannot login with a TOTP code\n body: %s", username, string(body))
+		}
+
+		return nil
+	}
+}
+
+// generateTotpCode generates a HmacSHA1 TOTP code as described in RFC 6238.
+func generateTotpCode(secret []byte, now time.Time, period, digits int) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(now.Unix()/int64(period)))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, code%modulo)
+}
+
+func testAccCheckKeycloakUserHasCredentials(resourceName string, credentialTypes ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		credentials, err := keycloakClient.GetUserCredentials(testCtx, user.RealmId, user.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, credentialType := range credentialTypes {
+			found := false
+			for _, credential := range credentials {
+				if credential.Type == credentialType {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("expected user %s to have a credential of type %s", user.Username, credentialType)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakUserDestroy() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -451,6 +681,154 @@ resource "keycloak_user" "user" {
 	`, testAccRealm.Realm, clientId, username, password)
 }
 
+func testKeycloakUser_multivaluedAttributes(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	attributes = {
+		joined = "d##e"
+	}
+
+	multivalued_attributes {
+		name   = "list"
+		values = ["a##b", "c"]
+	}
+}
+
+data "keycloak_user" "user" {
+	realm_id = keycloak_user.user.realm_id
+	username = keycloak_user.user.username
+}
+	`, testAccRealm.Realm, username)
+}
+
+func testKeycloakUser_credentials(username, clientId, userLabel string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = data.keycloak_realm.realm.id
+	client_id                    = "%s"
+
+	name                         = "test client"
+	enabled                      = true
+
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	credential {
+		type       = "password"
+		user_label = "%s"
+
+		hashed_password {
+			value           = "pT9BenwvidT/DBAB4jRfJVlKx3+Sq94JaHun/E4no+vcY5lLCZuhafPlM/i8xHc/yj47k0f/SrCyGnkTyRqZpw=="
+			salt            = "dGYtYWNjLXNhbHQtMTIzNA=="
+			algorithm       = "pbkdf2-sha256"
+			hash_iterations = 27500
+		}
+	}
+}
+
+resource "keycloak_user" "user_with_otp" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-otp"
+
+	credential {
+		type       = "password"
+		user_label = "%s"
+
+		hashed_password {
+			value           = "pT9BenwvidT/DBAB4jRfJVlKx3+Sq94JaHun/E4no+vcY5lLCZuhafPlM/i8xHc/yj47k0f/SrCyGnkTyRqZpw=="
+			salt            = "dGYtYWNjLXNhbHQtMTIzNA=="
+			algorithm       = "pbkdf2-sha256"
+			hash_iterations = 27500
+		}
+	}
+
+	credential {
+		type       = "otp"
+		user_label = "%s"
+
+		otp {
+			secret = "tf-acc-otp-secret-1234"
+		}
+	}
+}
+	`, testAccRealm.Realm, clientId, username, userLabel, username, userLabel, userLabel)
+}
+
+func testKeycloakUser_federatedIdentities(realm, username string, withFederatedIdentity bool) string {
+	federatedIdentity := ""
+	if withFederatedIdentity {
+		federatedIdentity = `
+	federated_identity {
+		identity_provider = keycloak_oidc_identity_provider.idp_a.alias
+		user_id           = "remote-id"
+		user_name         = "remote-user"
+	}`
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "idp_a" {
+	realm             = keycloak_realm.realm.id
+	alias             = "remote-a"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "client"
+	client_secret     = "secret"
+}
+
+resource "keycloak_oidc_identity_provider" "idp_b" {
+	realm             = keycloak_realm.realm.id
+	alias             = "remote-b"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "client"
+	client_secret     = "secret"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "%s"
+%s
+}
+	`, realm, username, federatedIdentity)
+}
+
+func testKeycloakUser_credentialWithoutHashedPassword(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	credential {
+		type = "password"
+	}
+}
+	`, testAccRealm.Realm, username)
+}
+
 func testKeycloakUser_fromInterface(user *keycloak.User) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// The functions in this file validate values that refer to providers, component types or themes installed on the
+// Keycloak server while planning, so that typos fail `terraform plan` instead of partway through an apply.
+//
+// Values are only validated when they change and are known. If the server info cannot be retrieved, for example because
+// the Keycloak server is provisioned in the same apply, validation is left to the server.
+
+type serverInfoValidationFunc func(serverInfo *keycloak.ServerInfo, value string) error
+
+func customizeDiffValidateServerInfo(attribute string, validate serverInfoValidationFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.HasChange(attribute) || !d.NewValueKnown(attribute) {
+			return nil
+		}
+
+		var values []string
+		switch v := d.Get(attribute).(type) {
+		case string:
+			values = []string{v}
+		case *schema.Set:
+			values = interfaceSliceToStringSlice(v.List())
+		case []interface{}:
+			values = interfaceSliceToStringSlice(v)
+		}
+
+		return validateServerInfoValues(ctx, meta, values, validate)
+	}
+}
+
+// customizeDiffValidateServerInfoBlocks validates an attribute of every element of a list or set of blocks, such as the
+// executor of each executor block.
+func customizeDiffValidateServerInfoBlocks(block, attribute string, validate serverInfoValidationFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.HasChange(block) || !d.NewValueKnown(block) {
+			return nil
+		}
+
+		var elements []interface{}
+		switch v := d.Get(block).(type) {
+		case *schema.Set:
+			elements = v.List()
+		case []interface{}:
+			elements = v
+		}
+
+		var values []string
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+
+			if value, ok := element.(map[string]interface{})[attribute].(string); ok {
+				values = append(values, value)
+			}
+		}
+
+		return validateServerInfoValues(ctx, meta, values, validate)
+	}
+}
+
+func validateServerInfoValues(ctx context.Context, meta interface{}, values []string, validate serverInfoValidationFunc) error {
+	if len(values) == 0 || (len(values) == 1 && values[0] == "") {
+		return nil
+	}
+
+	keycloakClient, ok := meta.(*keycloak.KeycloakClient)
+	if !ok || keycloakClient == nil {
+		return nil
+	}
+
+	serverInfo, err := keycloakClient.GetCachedServerInfo(ctx)
+	if err != nil {
+		return nil
+	}
+
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+
+		if err := validate(serverInfo, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validates that at least one of the given provider types has a provider with the given name. The description is used
+// in the error message, e.g. "required action".
+func validateProviderInstalled(description string, providerTypes ...string) serverInfoValidationFunc {
+	return func(serverInfo *keycloak.ServerInfo, value string) error {
+		var installed []string
+
+		for _, providerType := range providerTypes {
+			if serverInfo.ProviderIsInstalled(providerType, value) {
+				return nil
+			}
+
+			installed = append(installed, serverInfo.GetInstalledProvidersNames(providerType)...)
+		}
+
+		return fmt.Errorf("validation error: %s \"%s\" does not exist on the server, installed providers: %s", description, value, strings.Join(installed, ", "))
+	}
+}
+
+func validateComponentTypeInstalled(description, componentType string) serverInfoValidationFunc {
+	return func(serverInfo *keycloak.ServerInfo, value string) error {
+		if serverInfo.ComponentTypeIsInstalled(componentType, value) {
+			return nil
+		}
+
+		return fmt.Errorf("validation error: %s with id %s is not installed on the server, installed providers: %s", description, value, strings.Join(serverInfo.GetInstalledComponentTypeIds(componentType), ", "))
+	}
+}
+
+func validatePasswordPolicyAvailable() serverInfoValidationFunc {
+	return func(serverInfo *keycloak.ServerInfo, value string) error {
+		if serverInfo.PasswordPolicyIsAvailable(value) {
+			return nil
+		}
+
+		return fmt.Errorf("validation error: password policy \"%s\" does not exist on the server, available password policies: %s", value, strings.Join(serverInfo.GetPasswordPolicyIds(), ", "))
+	}
+}
+
+func validateThemeInstalled(themeType string) serverInfoValidationFunc {
+	return func(serverInfo *keycloak.ServerInfo, value string) error {
+		if serverInfo.ThemeIsInstalled(themeType, value) {
+			return nil
+		}
+
+		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server, installed %s themes: %s", value, themeType, strings.Join(serverInfo.GetInstalledThemeNames(themeType), ", "))
+	}
+}
+
+// checkServerFeatureEnabled is used by resources for optional Keycloak features, such as organizations, so that a
+// missing feature is reported clearly instead of as a 404 from an unknown endpoint.
+func checkServerFeatureEnabled(ctx context.Context, keycloakClient *keycloak.KeycloakClient, featureName, description string) diag.Diagnostics {
+	serverInfo, err := keycloakClient.GetCachedServerInfo(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !serverInfo.FeatureIsEnabled(featureName) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("this resource requires the %s feature to be enabled on the Keycloak server", description),
+		}}
+	}
+
+	return nil
+}
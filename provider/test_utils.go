@@ -90,6 +90,17 @@ func skipIfVersionIsGreaterThanOrEqualTo(ctx context.Context, t *testing.T, keyc
 	}
 }
 
+func skipIfFeatureIsNotEnabled(ctx context.Context, t *testing.T, keycloakClient *keycloak.KeycloakClient, feature string) {
+	serverInfo, err := keycloakClient.GetCachedServerInfo(ctx)
+	if err != nil {
+		t.Errorf("error getting keycloak server info: %v", err)
+	}
+
+	if err == nil && !serverInfo.FeatureIsEnabled(feature) {
+		t.Skipf("keycloak server does not have the %s feature enabled, skipping...", feature)
+	}
+}
+
 func TestCheckResourceAttrNot(name, key, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		err := resource.TestCheckResourceAttr(name, key, value)(s)
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"time"
@@ -53,6 +54,27 @@ func getDurationStringFromSeconds(seconds int) string {
 	return (time.Duration(seconds) * time.Second).String()
 }
 
+// Validates that a value is a duration string that is not negative, such as "24h"
+func validateDurationString(i interface{}, k string) (s []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("expected %s to be a duration string such as 24h, got %s: %s", k, v, err))
+		return
+	}
+
+	if duration < 0 {
+		errs = append(errs, fmt.Errorf("expected %s to not be negative, got %s", k, v))
+	}
+
+	return
+}
+
 // This will suppress the Terraform diff when comparing duration strings.
 // As long as both strings represent the same number of seconds, it makes no difference to the Keycloak API
 func suppressDurationStringDiff(_, old, new string, _ *schema.ResourceData) bool {
@@ -78,6 +78,12 @@ serviceAccountAdminRoleMapping=$(jq -n "[{
 
 post "/realms/master/users/${terraformClientServiceAccountId}/role-mappings/realm" "${serviceAccountAdminRoleMapping}"
 
+echo "Setting the email of the terraform client service account, which receives the SMTP test emails"
+
+terraformClientServiceAccountWithEmail=$(echo ${terraformClientServiceAccount} | jq '.email = "terraform@localhost"')
+
+put "/realms/master/users/${terraformClientServiceAccountId}" "${terraformClientServiceAccountWithEmail}"
+
 echo "Extending access token lifespan (don't do this in production)"
 
 masterRealmExtendAccessToken=$(jq -n "{